@@ -0,0 +1,10 @@
+data "twingate_connector_status" "green" {
+  remote_network_id = twingate_remote_network.aws_network.id
+  min_version       = "1.62.0"
+
+  timeouts {
+    read = "15m"
+  }
+
+  depends_on = [twingate_connector.green]
+}
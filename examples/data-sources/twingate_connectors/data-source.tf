@@ -6,3 +6,19 @@ data "twingate_connectors" "all" {
   #  name_prefix = "<prefix of connector name>"
   #  name_suffix = "<suffix of connector name>"
 }
+
+# Connectors in a Remote Network running a version older than 1.60.0
+data "twingate_connectors" "stale" {
+  remote_network_id = "<your remote network's id>"
+  version_less_than = "1.60.0"
+  #  state = "ALIVE"
+  #  status_updates_enabled = true
+}
+
+output "dead_connectors" {
+  value = data.twingate_connectors.stale.state_counts["DEAD_NO_HEARTBEAT"]
+}
+
+output "oldest_connector_version" {
+  value = data.twingate_connectors.stale.oldest_version
+}
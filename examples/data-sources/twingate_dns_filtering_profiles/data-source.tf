@@ -0,0 +1,10 @@
+provider "twingate" {
+  api_token = "1234567890abcdef"
+  network   = "mynetwork"
+}
+
+data "twingate_dns_filtering_profiles" "all" {}
+
+output "unassigned_profiles" {
+  value = [for profile in data.twingate_dns_filtering_profiles.all.dns_filtering_profiles : profile.name if profile.group_count == 0]
+}
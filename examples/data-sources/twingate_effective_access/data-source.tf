@@ -0,0 +1,17 @@
+# Every Resource a User can reach, with the Group granting access
+data "twingate_effective_access" "alice" {
+  email = "alice@example.com"
+}
+
+output "alice_access" {
+  value = data.twingate_effective_access.alice.resources
+}
+
+# Every User and Service Account that can reach a Resource
+data "twingate_effective_access" "database" {
+  resource_id = "<your resource's id>"
+}
+
+output "database_users" {
+  value = [for user in data.twingate_effective_access.database.users : "${user.email} via ${user.group_name}"]
+}
@@ -12,4 +12,28 @@ data "twingate_resources" "foo" {
 }
 
 # Resource names are not constrained to be unique within Twingate,
-# so it is possible that this data source will return multiple list items.
\ No newline at end of file
+# so it is possible that this data source will return multiple list items.
+
+# Resources whose name starts with "prod-" and doesn't contain "legacy"
+data "twingate_resources" "prod" {
+  filter {
+    condition {
+      field    = "name"
+      operator = "prefix"
+      value    = "prod-"
+    }
+
+    condition {
+      field    = "name"
+      operator = "contains"
+      value    = "legacy"
+      negate   = true
+    }
+  }
+}
+
+# Resources exposing any part of 10.20.0.0/16
+data "twingate_resources" "vpc" {
+  address      = "10.20.0.0/16"
+  address_mode = "cidr_overlaps"
+}
\ No newline at end of file
@@ -0,0 +1,12 @@
+data "twingate_service_account_keys" "expiring" {
+  status          = "ACTIVE"
+  expiring_within = 14
+  #  service_account_id = "<your service account's id>"
+}
+
+check "service_account_keys_expiry" {
+  assert {
+    condition     = length(data.twingate_service_account_keys.expiring.keys) == 0
+    error_message = "Service account keys expiring within 14 days: ${join(", ", data.twingate_service_account_keys.expiring.keys[*].name)}"
+  }
+}
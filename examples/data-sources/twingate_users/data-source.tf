@@ -21,4 +21,22 @@ data "twingate_users" "all" {
   #  last_name_suffix = "<suffix of user last name>"
 
   #  roles = ["ADMIN", "DEVOPS", "SUPPORT", "MEMBER"]
+
+  #  state = "ACTIVE"
+  #  type = "SYNCED"
+  #  group_id = "<your group's id>"
+  #  reverse_lookup = true
+}
+
+# Disabled or pending users still belonging to a privileged Group
+data "twingate_users" "offboarding" {
+  group_id = "<your privileged group's id>"
+
+  filter {
+    condition {
+      field  = "state"
+      value  = "ACTIVE"
+      negate = true
+    }
+  }
 }
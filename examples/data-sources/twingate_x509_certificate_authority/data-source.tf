@@ -6,3 +6,13 @@ provider "twingate" {
 data "twingate_x509_certificate_authority" "example" {
   id = "<your x509 certificate authority's id>"
 }
+
+# example exposing the certificate's expiry for monitoring
+data "twingate_x509_certificate_authority" "monitored" {
+  id          = "<your x509 certificate authority's id>"
+  certificate = file("${path.module}/certs/ca.pem")
+}
+
+output "ca_not_after" {
+  value = data.twingate_x509_certificate_authority.monitored.not_after
+}
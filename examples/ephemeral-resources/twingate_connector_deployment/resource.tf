@@ -0,0 +1,36 @@
+provider "twingate" {
+  api_token = "1234567890abcdef"
+  network   = "mynetwork"
+}
+
+resource "twingate_remote_network" "aws_network" {
+  name = "aws_remote_network"
+}
+
+resource "twingate_connector" "aws_connector" {
+  remote_network_id = twingate_remote_network.aws_network.id
+}
+
+resource "twingate_connector_tokens" "aws_connector_tokens" {
+  connector_id = twingate_connector.aws_connector.id
+}
+
+ephemeral "twingate_connector_deployment" "aws_connector" {
+  connector_id  = twingate_connector.aws_connector.id
+  format        = "kubernetes"
+  name          = "aws-connector"
+  namespace     = "twingate"
+  access_token  = twingate_connector_tokens.aws_connector_tokens.access_token
+  refresh_token = twingate_connector_tokens.aws_connector_tokens.refresh_token
+}
+
+# Store the rendered Secret without writing the tokens to state
+resource "aws_secretsmanager_secret" "connector_manifest" {
+  name = "twingate-connector-secret-manifest"
+}
+
+resource "aws_secretsmanager_secret_version" "connector_manifest" {
+  secret_id                = aws_secretsmanager_secret.connector_manifest.id
+  secret_string_wo_version = 1
+  secret_string_wo         = ephemeral.twingate_connector_deployment.aws_connector.files["secret.yaml"]
+}
@@ -0,0 +1,18 @@
+variable "connector_access_token" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+variable "connector_refresh_token" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+# Fails the run when Twingate no longer accepts the tokens
+ephemeral "twingate_connector_tokens_verification" "aws_connector" {
+  access_token  = var.connector_access_token
+  refresh_token = var.connector_refresh_token
+  require_valid = true
+}
@@ -0,0 +1,48 @@
+provider "twingate" {
+  api_token = "1234567890abcdef"
+  network   = "mynetwork"
+}
+
+variable "gateway_ca_private_key" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "twingate_remote_network" "prod" {
+  name = "Production"
+}
+
+resource "twingate_x509_certificate_authority" "tls" {
+  name        = "My TLS CA"
+  certificate = file("${path.module}/certs/ca.pem")
+}
+
+resource "twingate_gateway" "main" {
+  remote_network_id = twingate_remote_network.prod.id
+  address           = "gateway.prod.internal:8443"
+  x509_ca_id        = twingate_x509_certificate_authority.tls.id
+}
+
+ephemeral "twingate_gateway_tls_bundle" "main" {
+  gateway_id     = twingate_gateway.main.id
+  ca_certificate = file("${path.module}/certs/ca.pem")
+  ca_private_key = var.gateway_ca_private_key
+  ip_addresses   = ["10.0.0.1"]
+  validity       = "30d"
+}
+
+# Store the bundle without writing the private key to state, then mount it
+# at the Gateway's tls.certificate_file and tls.private_key_file.
+resource "aws_secretsmanager_secret" "gateway_tls" {
+  name = "twingate-gateway-tls"
+}
+
+resource "aws_secretsmanager_secret_version" "gateway_tls" {
+  secret_id                = aws_secretsmanager_secret.gateway_tls.id
+  secret_string_wo_version = 1
+  secret_string_wo = jsonencode({
+    "tls.crt" = ephemeral.twingate_gateway_tls_bundle.main.certificate
+    "tls.key" = ephemeral.twingate_gateway_tls_bundle.main.private_key
+  })
+}
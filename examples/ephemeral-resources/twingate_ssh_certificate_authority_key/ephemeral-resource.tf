@@ -0,0 +1,37 @@
+provider "twingate" {
+  api_token = "1234567890abcdef"
+  network   = "mynetwork"
+}
+
+resource "twingate_ssh_certificate_authority" "ssh" {
+  name = "My generated SSH CA"
+  generate_key = {
+    type             = "ed25519"
+    private_key_file = "${path.module}/keys/ca"
+  }
+}
+
+ephemeral "twingate_ssh_certificate_authority_key" "ssh" {
+  private_key_file = twingate_ssh_certificate_authority.ssh.generate_key.private_key_file
+  public_key       = twingate_ssh_certificate_authority.ssh.public_key
+}
+
+# Store the private key without writing it to state, then mount it
+# at the Gateway's ssh.ca.private_key_file.
+resource "aws_secretsmanager_secret" "gateway_ssh_ca" {
+  name = "twingate-gateway-ssh-ca"
+}
+
+resource "aws_secretsmanager_secret_version" "gateway_ssh_ca" {
+  secret_id                = aws_secretsmanager_secret.gateway_ssh_ca.id
+  secret_string_wo_version = 1
+  secret_string_wo         = ephemeral.twingate_ssh_certificate_authority_key.ssh.private_key
+}
+
+resource "twingate_gateway_config" "config" {
+  ssh = {
+    ca = {
+      private_key_file = "/etc/gateway/ssh-ca"
+    }
+  }
+}
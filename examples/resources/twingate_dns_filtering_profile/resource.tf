@@ -31,11 +31,12 @@ resource "twingate_dns_filtering_profile" "example" {
     ]
   }
 
+  // Thousands of entries can be loaded from hosts-file, AdBlock-style or plain lists.
+  // The plan only shows how many domains are added and removed.
   denied_domains {
     is_authoritative = true
-    domains = [
-      "evil.example"
-    ]
+    source           = file("${path.module}/blocklist.hosts")
+    source_format    = "hosts"
   }
 
   content_categories {
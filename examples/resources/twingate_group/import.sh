@@ -1 +1,4 @@
-terraform import twingate_group.aws R3JvdXA6MzQ4OTE=
\ No newline at end of file
+terraform import twingate_group.aws R3JvdXA6MzQ4OTE=
+
+# or by name
+terraform import twingate_group.aws name:aws_group
\ No newline at end of file
@@ -1 +1,4 @@
-terraform import twingate_remote_network.network UmVtb3RlTmV0d29zaipgMKIkNg==
\ No newline at end of file
+terraform import twingate_remote_network.network UmVtb3RlTmV0d29zaipgMKIkNg==
+
+# or by name
+terraform import twingate_remote_network.network name:aws_remote_network
\ No newline at end of file
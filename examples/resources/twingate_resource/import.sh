@@ -1 +1,5 @@
-terraform import twingate_resource.resource UmVzb3VyY2U6MzQwNDQ3
\ No newline at end of file
+terraform import twingate_resource.resource UmVzb3VyY2U6MzQwNDQ3
+
+# or by remote network name and resource name or address
+terraform import twingate_resource.resource aws_remote_network/network
+terraform import twingate_resource.resource aws_remote_network/internal.int
\ No newline at end of file
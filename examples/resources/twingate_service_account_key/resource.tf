@@ -29,4 +29,14 @@ resource "twingate_service_account_key" "github_key_with_rotation" {
   lifecycle {
     replace_triggered_by = [time_static.key_rotation]
   }
+}
+
+// Built-in key rotation: the new key is created before the old one is revoked
+
+resource "twingate_service_account_key" "github_key_with_builtin_rotation" {
+  name                 = "Github Actions PROD key (rotated by the provider)"
+  service_account_id   = twingate_service_account.github_actions_prod.id
+  expiration_time      = 90
+  rotation_period      = "30d"
+  rotate_before_expiry = "7d"
 }
\ No newline at end of file
@@ -13,3 +13,12 @@ resource "twingate_ssh_certificate_authority" "example_from_file" {
   name       = "My SSH CA from file"
   public_key = trimspace(file("${path.module}/keys/ca.pub"))
 }
+
+# example generating the key pair and handing the private key to the Gateway
+resource "twingate_ssh_certificate_authority" "example_generated" {
+  name = "My generated SSH CA"
+  generate_key = {
+    type             = "ed25519"
+    private_key_file = "${path.module}/keys/ca"
+  }
+}
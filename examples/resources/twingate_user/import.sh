@@ -1 +1,4 @@
-terraform import twingate_user.user VXNlcjo1ODk3MTM=
\ No newline at end of file
+terraform import twingate_user.user VXNlcjo1ODk3MTM=
+
+# or by email
+terraform import twingate_user.user email:user@example.com
\ No newline at end of file
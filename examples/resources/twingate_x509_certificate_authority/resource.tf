@@ -34,3 +34,14 @@ QqoqrT++W7pQ44zAfEgTUhOvqQEo9o8sWk8ctbOZ0DsSDyG4jZGD5Xj+bYGKq2Ns
 -----END CERTIFICATE-----
 EOF
 }
+
+# example with an intermediate CA and its root, warning 90 days before either expires
+resource "twingate_x509_certificate_authority" "test_chain" {
+  name           = "chain example"
+  certificate    = join("", [file("${path.module}/certs/intermediate.pem"), file("${path.module}/certs/root.pem")])
+  expiry_warning = "90d"
+}
+
+output "ca_expires" {
+  value = twingate_x509_certificate_authority.test_chain.not_after
+}
@@ -8,6 +8,10 @@ const (
 	Type              = "type"
 	IsActive          = "is_active"
 
+	DeletionProtection = "deletion_protection"
+	Timeouts           = "timeouts"
+	ReverseLookup      = "reverse_lookup"
+
 	FilterByRegexp   = "_regexp"
 	FilterByContains = "_contains"
 	FilterByExclude  = "_exclude"
@@ -0,0 +1,9 @@
+package attr
+
+const (
+	Format         = "format"
+	Image          = "image"
+	Namespace      = "namespace"
+	Files          = "files"
+	GenerateTokens = "generate_tokens"
+)
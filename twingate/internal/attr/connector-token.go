@@ -5,4 +5,7 @@ const (
 	Keepers      = "keepers"
 	AccessToken  = "access_token"
 	RefreshToken = "refresh_token"
+	VerifyOnRead = "verify_on_read"
+	RequireValid = "require_valid"
+	Valid        = "valid"
 )
@@ -7,4 +7,11 @@ const (
 	Version              = "version"
 	PublicIP             = "public_ip"
 	PrivateIPs           = "private_ips"
+	WaitForState         = "wait_for_state"
+	MinVersion           = "min_version"
+	Ready                = "ready"
+	Wait                 = "wait"
+	VersionLessThan      = "version_less_than"
+	StateCounts          = "state_counts"
+	OldestVersion        = "oldest_version"
 )
@@ -3,9 +3,14 @@ package attr
 const (
 	Priority                        = "priority"
 	FallbackMethod                  = "fallback_method"
+	DNSFilteringProfiles            = "dns_filtering_profiles"
+	GroupCount                      = "group_count"
 	AllowedDomains                  = "allowed_domains"
 	DeniedDomains                   = "denied_domains"
 	Domains                         = "domains"
+	Source                          = "source"
+	SourceFormat                    = "source_format"
+	SourceSummary                   = "source_summary"
 	PrivacyCategories               = "privacy_categories"
 	BlockAffiliateLinks             = "block_affiliate_links"
 	BlockDisguisedTrackers          = "block_disguised_trackers"
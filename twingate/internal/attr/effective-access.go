@@ -0,0 +1,7 @@
+package attr
+
+const (
+	UserID     = "user_id"
+	ResourceID = "resource_id"
+	GroupName  = "group_name"
+)
@@ -0,0 +1,13 @@
+package attr
+
+const (
+	Filter    = "filter"
+	Match     = "match"
+	Condition = "condition"
+	Field     = "field"
+	Key       = "key"
+	Operator  = "operator"
+	Value     = "value"
+	Negate    = "negate"
+	Tag       = "tag"
+)
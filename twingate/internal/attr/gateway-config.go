@@ -21,4 +21,5 @@ const (
 	Mount               = "mount"
 	GCP                 = "gcp"
 	ServiceAccountEmail = "service_account_email"
+	DiscoveredResources = "discovered_resources"
 )
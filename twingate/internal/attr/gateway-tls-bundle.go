@@ -0,0 +1,10 @@
+package attr
+
+const (
+	CACertificate = "ca_certificate"
+	CAPrivateKey  = "ca_private_key"
+	DNSNames      = "dns_names"
+	IPAddresses   = "ip_addresses"
+	Validity      = "validity"
+	PrivateKey    = "private_key"
+)
@@ -12,4 +12,7 @@ const (
 	DefaultTags     = "default_tags"
 	ResourcesFilter = "resources_filter"
 	GroupsFilter    = "groups_filter"
+
+	ResourceAddressConflicts             = "resource_address_conflicts"
+	DNSFilteringProfilePriorityConflicts = "dns_filtering_profile_priority_conflicts"
 )
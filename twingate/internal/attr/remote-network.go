@@ -3,4 +3,7 @@ package attr
 const (
 	Location       = "location"
 	RemoteNetworks = "remote_networks"
+	ForceDestroy   = "force_destroy"
+	ConnectorIDs   = "connector_ids"
+	GatewayIDs     = "gateway_ids"
 )
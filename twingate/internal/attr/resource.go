@@ -26,4 +26,6 @@ const (
 	Mode                           = "mode"
 	Duration                       = "duration"
 	RoutingMode                    = "routing_mode"
+	DestroyBehavior                = "destroy_behavior"
+	AddressMode                    = "address_mode"
 )
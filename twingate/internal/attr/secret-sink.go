@@ -0,0 +1,11 @@
+package attr
+
+const (
+	SecretSink         = "secret_sink"
+	File               = "file"
+	Command            = "command"
+	HTTP               = "http"
+	TokenSHA256        = "token_sha256"
+	AccessTokenSHA256  = "access_token_sha256"
+	RefreshTokenSHA256 = "refresh_token_sha256"
+)
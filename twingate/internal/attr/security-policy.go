@@ -2,4 +2,5 @@ package attr
 
 const (
 	SecurityPolicies = "security_policies"
+	AccessGrants     = "access_grants"
 )
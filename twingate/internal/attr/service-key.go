@@ -4,4 +4,13 @@ const (
 	ServiceAccountID = "service_account_id"
 	Token            = "token"
 	ExpirationTime   = "expiration_time"
+
+	RotationPeriod     = "rotation_period"
+	RotateBeforeExpiry = "rotate_before_expiry"
+
+	Keys           = "keys"
+	Status         = "status"
+	ExpiresAt      = "expires_at"
+	DaysRemaining  = "days_remaining"
+	ExpiringWithin = "expiring_within"
 )
@@ -1,5 +1,7 @@
 package attr
 
 const (
-	PublicKey = "public_key"
+	PublicKey          = "public_key"
+	GenerateKey        = "generate_key"
+	OpenSSHFingerprint = "openssh_fingerprint"
 )
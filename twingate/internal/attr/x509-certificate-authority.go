@@ -1,6 +1,13 @@
 package attr
 
 const (
-	Certificate = "certificate"
-	Fingerprint = "fingerprint"
+	Certificate   = "certificate"
+	Fingerprint   = "fingerprint"
+	Subject       = "subject"
+	Issuer        = "issuer"
+	NotBefore     = "not_before"
+	NotAfter      = "not_after"
+	Serial        = "serial"
+	KeyAlgorithm  = "key_algorithm"
+	ExpiryWarning = "expiry_warning"
 )
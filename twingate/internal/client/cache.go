@@ -5,6 +5,7 @@ import (
 	"log"
 	"reflect"
 	"sync"
+	"sync/atomic"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/mitchellh/copystructure"
@@ -87,6 +88,7 @@ func (c *clientCache) setClient(ctx context.Context, client ReadClient, opts Cac
 type resourceHandler interface {
 	isEnabled() bool
 	isFilterSet() bool
+	isLoaded() bool
 	init() error
 	getResource(resourceID string) (any, bool)
 	setResource(resource identifiable)
@@ -105,6 +107,7 @@ type handler[T identifiable, F any] struct {
 	once    sync.Once
 	enabled bool
 	filter  F
+	loaded  atomic.Bool
 
 	resources       sync.Map
 	readResources   readResourcesFunc[T]
@@ -119,6 +122,11 @@ func (h *handler[T, F]) isFilterSet() bool {
 	return !isNil(h.filter)
 }
 
+// isLoaded reports whether the initial read succeeded, so the cache holds every object that matches the filter.
+func (h *handler[T, F]) isLoaded() bool {
+	return h.loaded.Load()
+}
+
 func (h *handler[T, F]) getResource(resourceID string) (any, bool) {
 	var emptyObj T
 
@@ -219,6 +227,7 @@ func (h *handler[T, F]) init() error {
 		}
 
 		h.setResources(resources)
+		h.loaded.Store(true)
 
 		log.Printf("[TWINGATE_LOG] cache init for type %T: finished.", res)
 	})
@@ -312,6 +321,20 @@ func isCacheReady[T any]() bool {
 	return ready
 }
 
+// isCacheComplete reports whether the cache is enabled without a filter and was loaded, so it holds every object of the type.
+func isCacheComplete[T any]() bool {
+	var (
+		res      T
+		complete = false
+	)
+
+	handle(res, func(handler resourceHandler) {
+		complete = handler.isEnabled() && !handler.isFilterSet() && handler.isLoaded()
+	})
+
+	return complete
+}
+
 func isNil(obj any) bool {
 	val := reflect.ValueOf(obj)
 	//nolint:exhaustive
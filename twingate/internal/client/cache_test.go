@@ -807,3 +807,26 @@ func TestHandler_GroupsCacheReady(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_IsLoaded(t *testing.T) {
+	loaded := &handler[*model.Resource, *model.ResourcesFilter]{
+		enabled: true,
+		readResources: func(ctx context.Context) ([]*model.Resource, error) {
+			return []*model.Resource{{ID: "res1"}}, nil
+		},
+	}
+
+	assert.False(t, loaded.isLoaded())
+	assert.NoError(t, loaded.init())
+	assert.True(t, loaded.isLoaded())
+
+	failed := &handler[*model.Resource, *model.ResourcesFilter]{
+		enabled: true,
+		readResources: func(ctx context.Context) ([]*model.Resource, error) {
+			return nil, ErrGraphqlResultIsEmpty
+		},
+	}
+
+	assert.Error(t, failed.init())
+	assert.False(t, failed.isLoaded())
+}
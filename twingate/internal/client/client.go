@@ -356,11 +356,47 @@ func (client *Client) mutate(ctx context.Context, resp MutationResponse, variabl
 	return nil
 }
 
+// queryAttemptTimeout bounds a single attempt of a query or mutation.
+//
+//nolint:gochecknoglobals
+var queryAttemptTimeout = defaultQueryTimeout
+
+// attemptContext bounds a single attempt by queryAttemptTimeout, or by the caller's deadline, e.g. from
+// a resource's timeouts block, when that is sooner. A hung request can then not use up the whole budget.
+func attemptContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout := queryAttemptTimeout
+
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = min(timeout, time.Until(deadline))
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+// shouldRetryTimeout reports whether an attempt timed out while the caller's context is still live.
+// Without a caller deadline the number of attempts is capped by defaultQueryRetries, otherwise
+// attempts are retried for as long as the caller's deadline allows.
+func shouldRetryTimeout(ctx context.Context, err error, attempt int) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+
+	if !strings.Contains(err.Error(), "timeout") && !strings.Contains(err.Error(), "deadline") {
+		return false
+	}
+
+	if _, ok := ctx.Deadline(); ok {
+		return true
+	}
+
+	return attempt < defaultQueryRetries
+}
+
 func (client *Client) mutateWithTimeout(ctx context.Context, resp MutationResponse, variables map[string]any, opr operation, attrs ...attr) error {
 	var err error
 
-	for i := 0; i == 0 || err != nil && (strings.Contains(err.Error(), "timeout") || strings.Contains(err.Error(), "deadline")) && i < defaultQueryRetries; i++ {
-		timeoutCtx, cancel := context.WithTimeout(ctx, defaultQueryTimeout)
+	for i := 0; i == 0 || shouldRetryTimeout(ctx, err, i); i++ {
+		timeoutCtx, cancel := attemptContext(ctx)
 		err = client.mutate(timeoutCtx, resp, variables, opr, attrs...)
 
 		cancel()
@@ -376,8 +412,8 @@ type ResponseWithPayload interface {
 func (client *Client) queryWithTimeout(ctx context.Context, resp ResponseWithPayload, variables map[string]any, opr operation, attrs ...attr) error {
 	var err error
 
-	for i := 0; i == 0 || err != nil && (strings.Contains(err.Error(), "timeout") || strings.Contains(err.Error(), "deadline")) && i < defaultQueryRetries; i++ {
-		timeoutCtx, cancel := context.WithTimeout(ctx, defaultQueryTimeout)
+	for i := 0; i == 0 || shouldRetryTimeout(ctx, err, i); i++ {
+		timeoutCtx, cancel := attemptContext(ctx)
 		err = client.query(timeoutCtx, resp, variables, opr, attrs...)
 
 		cancel()
@@ -13,6 +13,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
@@ -258,3 +259,94 @@ func TestCustomRetryPolicy_RequestBodyLogging(t *testing.T) {
 	logOutput := logBuffer.String()
 	assert.Contains(t, logOutput, "[WARN] [RETRY_POLICY] [id:test_id] request: "+requestBody)
 }
+
+func TestAttemptContextUsesDefaultTimeout(t *testing.T) {
+	ctx, cancel := attemptContext(context.Background())
+	defer cancel()
+
+	deadline, ok := ctx.Deadline()
+
+	assert.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(defaultQueryTimeout), deadline, time.Second)
+}
+
+func TestAttemptContextUsesSoonerCallerDeadline(t *testing.T) {
+	parent, parentCancel := context.WithTimeout(context.Background(), time.Second)
+	defer parentCancel()
+
+	ctx, cancel := attemptContext(parent)
+	defer cancel()
+
+	expected, _ := parent.Deadline()
+	deadline, ok := ctx.Deadline()
+
+	assert.True(t, ok)
+	assert.WithinDuration(t, expected, deadline, 10*time.Millisecond)
+}
+
+func TestAttemptContextCapsLaterCallerDeadline(t *testing.T) {
+	parent, parentCancel := context.WithTimeout(context.Background(), time.Hour)
+	defer parentCancel()
+
+	ctx, cancel := attemptContext(parent)
+	defer cancel()
+
+	deadline, ok := ctx.Deadline()
+
+	assert.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(defaultQueryTimeout), deadline, time.Second)
+}
+
+func TestShouldRetryTimeout(t *testing.T) {
+	expired, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	withDeadline, deadlineCancel := context.WithTimeout(context.Background(), time.Hour)
+	defer deadlineCancel()
+
+	assert.False(t, shouldRetryTimeout(context.Background(), nil, 1))
+	assert.False(t, shouldRetryTimeout(context.Background(), errors.New("bad request"), 1))
+	assert.True(t, shouldRetryTimeout(context.Background(), errors.New("context deadline exceeded"), 1))
+	assert.False(t, shouldRetryTimeout(context.Background(), errors.New("context deadline exceeded"), defaultQueryRetries))
+	assert.True(t, shouldRetryTimeout(withDeadline, errors.New("context deadline exceeded"), defaultQueryRetries))
+	assert.False(t, shouldRetryTimeout(expired, errors.New("context deadline exceeded"), 1))
+}
+
+func TestQueryWithTimeoutRetriesStalledAttemptWithinCallerDeadline(t *testing.T) {
+	attemptTimeout := queryAttemptTimeout
+	queryAttemptTimeout = 100 * time.Millisecond
+
+	t.Cleanup(func() {
+		queryAttemptTimeout = attemptTimeout
+	})
+
+	client := newTestClient(t.Context())
+	httpmock.ActivateNonDefault(client.HTTPClient)
+	defer httpmock.DeactivateAndReset()
+
+	var calls int
+
+	httpmock.RegisterResponder("POST", client.GraphqlServerURL,
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+
+			if calls == 1 {
+				// the first response stalls until the attempt gives up on it
+				<-req.Context().Done()
+
+				return nil, req.Context().Err()
+			}
+
+			return httpmock.NewStringResponse(http.StatusOK,
+				`{"data":{"dnsFilteringProfiles":[{"id":"profile-1","name":"profile","priority":1}]}}`), nil
+		})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	profiles, err := client.ReadShallowDNSFilteringProfiles(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []*model.DNSFilteringProfile{{ID: "profile-1", Name: "profile", Priority: 1}}, profiles)
+}
@@ -2,6 +2,8 @@ package client
 
 import (
 	"context"
+	"errors"
+	"net/http"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client/query"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
@@ -26,6 +28,14 @@ func (client *Client) VerifyConnectorTokens(ctx context.Context, refreshToken, a
 	return nil
 }
 
+// IsConnectorTokensInvalid reports whether VerifyConnectorTokens failed because Twingate rejected
+// the tokens, as opposed to the request itself failing.
+func IsConnectorTokensInvalid(err error) bool {
+	var httpErr *HTTPError
+
+	return errors.As(err, &httpErr) && (httpErr.StatusCode == http.StatusUnauthorized || httpErr.StatusCode == http.StatusForbidden)
+}
+
 func (client *Client) GenerateConnectorTokens(ctx context.Context, connectorID string) (*model.ConnectorTokens, error) {
 	opr := resourceConnectorToken.generate()
 
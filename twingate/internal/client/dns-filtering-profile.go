@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client/query"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
@@ -18,6 +19,32 @@ func (client *Client) ReadShallowDNSFilteringProfiles(ctx context.Context) ([]*m
 	return response.ToModel(), nil
 }
 
+// ReadDNSFilteringProfiles returns every profile with its fallback method and groups.
+// The list query only returns IDs, names and priorities, so each profile is read separately.
+func (client *Client) ReadDNSFilteringProfiles(ctx context.Context) ([]*model.DNSFilteringProfile, error) {
+	shallowProfiles, err := client.ReadShallowDNSFilteringProfiles(ctx)
+	if err != nil {
+		if errors.Is(err, ErrGraphqlResultIsEmpty) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	profiles := make([]*model.DNSFilteringProfile, 0, len(shallowProfiles))
+
+	for _, shallowProfile := range shallowProfiles {
+		profile, err := client.ReadDNSFilteringProfile(ctx, shallowProfile.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		profiles = append(profiles, profile)
+	}
+
+	return profiles, nil
+}
+
 func (client *Client) ReadDNSFilteringProfile(ctx context.Context, profileID string) (*model.DNSFilteringProfile, error) {
 	opr := resourceDNSFilteringProfile.read()
 
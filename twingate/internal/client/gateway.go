@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client/query"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
@@ -55,6 +56,81 @@ func (client *Client) ReadGateway(ctx context.Context, gatewayID string) (*model
 	return response.ToModel(), nil
 }
 
+func (client *Client) ReadGateways(ctx context.Context) ([]*model.Gateway, error) {
+	opr := resourceGateway.read().withCustomName("readGateways")
+
+	variables := newVars(
+		cursor(query.CursorGateways),
+		pageLimit(client.pageLimit),
+	)
+
+	response := query.ReadGateways{}
+	if err := client.query(ctx, &response, variables, opr, attr{id: "All"}); err != nil && !errors.Is(err, ErrGraphqlResultIsEmpty) {
+		return nil, err
+	}
+
+	if err := response.FetchPages(ctx, client.readGatewaysAfter, variables); err != nil {
+		return nil, err //nolint
+	}
+
+	return response.ToModel(), nil
+}
+
+// ReadGatewayResources returns the SSH and Kubernetes Resources bound to the Gateway. The Gateway
+// can't be filtered on server-side, so all Resources are listed together with their Gateway.
+func (client *Client) ReadGatewayResources(ctx context.Context, gatewayID string) ([]*model.SSHResource, []*model.KubernetesResource, error) {
+	opr := resourceGateway.read().withCustomName("readGatewayResources")
+
+	if gatewayID == "" {
+		return nil, nil, opr.apiError(ErrGraphqlIDIsEmpty)
+	}
+
+	variables := newVars(
+		cursor(query.CursorResources),
+		pageLimit(client.pageLimit),
+	)
+
+	response := query.ReadGatewayResources{}
+	if err := client.query(ctx, &response, variables, opr, attr{id: gatewayID}); err != nil && !errors.Is(err, ErrGraphqlResultIsEmpty) {
+		return nil, nil, err
+	}
+
+	if err := response.FetchPages(withOperationCtx(ctx, opr), client.readGatewayResourcesAfter, variables); err != nil {
+		return nil, nil, err //nolint
+	}
+
+	sshResources, k8sResources := response.ToModel(gatewayID)
+
+	return sshResources, k8sResources, nil
+}
+
+func (client *Client) readGatewayResourcesAfter(ctx context.Context, variables map[string]any, cursor string) (*query.PaginatedResource[*query.GatewayResourceEdge], error) {
+	opr := resourceGateway.read().withCustomName("readGatewayResourcesAfter")
+
+	variables[query.CursorResources] = cursor
+
+	response := query.ReadGatewayResources{}
+	if err := client.query(ctx, &response, variables, opr, attr{id: "All"}); err != nil {
+		return nil, err
+	}
+
+	//nolint:staticcheck
+	return &response.GatewayResources.PaginatedResource, nil
+}
+
+func (client *Client) readGatewaysAfter(ctx context.Context, variables map[string]any, cursor string) (*query.PaginatedResource[*query.GatewayEdge], error) {
+	opr := resourceGateway.read().withCustomName("readGatewaysAfter")
+
+	variables[query.CursorGateways] = cursor
+
+	response := query.ReadGateways{}
+	if err := client.query(ctx, &response, variables, opr, attr{id: "All"}); err != nil {
+		return nil, err
+	}
+
+	return &response.PaginatedResource, nil
+}
+
 func (client *Client) UpdateGateway(ctx context.Context, gateway *model.Gateway) (*model.Gateway, error) {
 	opr := resourceGateway.update()
 
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadGateways(t *testing.T) {
+	cases := []struct {
+		name         string
+		responseBody string
+		expected     []*model.Gateway
+		expectedErr  bool
+	}{
+		{
+			name:         "empty edges - returns empty, no error",
+			responseBody: `{"data":{"gateways":{"pageInfo":{"endCursor":"","hasNextPage":false},"edges":[]}}}`,
+			expected:     []*model.Gateway{},
+		},
+		{
+			name: "gateways returned",
+			responseBody: `{"data":{"gateways":{"pageInfo":{"endCursor":"","hasNextPage":false},"edges":[
+				{"node":{"id":"gw-1","address":"10.0.0.1:8443","remoteNetwork":{"id":"rn-1"},"x509CA":{"id":"x509-1"},"sshCA":{"id":"ssh-1"}}},
+				{"node":{"id":"gw-2","address":"10.0.0.2:8443","remoteNetwork":{"id":"rn-2"},"x509CA":{"id":"x509-1"},"sshCA":null}}
+			]}}}`,
+			expected: []*model.Gateway{
+				{ID: "gw-1", Address: "10.0.0.1:8443", RemoteNetworkID: "rn-1", X509CAID: "x509-1", SSHCAID: "ssh-1"},
+				{ID: "gw-2", Address: "10.0.0.2:8443", RemoteNetworkID: "rn-2", X509CAID: "x509-1"},
+			},
+		},
+		{
+			name:         "graphql error - error propagated",
+			responseBody: `{"errors":[{"message":"server error","locations":[{"line":1,"column":1}]}]}`,
+			expectedErr:  true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			client := newTestClient(t.Context())
+			httpmock.ActivateNonDefault(client.HTTPClient)
+			defer httpmock.DeactivateAndReset()
+
+			httpmock.RegisterResponder("POST", client.GraphqlServerURL,
+				httpmock.NewStringResponder(200, c.responseBody))
+
+			gateways, err := client.ReadGateways(context.Background())
+
+			if c.expectedErr {
+				assert.Error(t, err)
+				assert.Nil(t, gateways)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, c.expected, gateways)
+			}
+		})
+	}
+}
+
+func TestReadGatewayResources(t *testing.T) {
+	client := newTestClient(t.Context())
+	httpmock.ActivateNonDefault(client.HTTPClient)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", client.GraphqlServerURL,
+		httpmock.ResponderFromMultipleResponses([]*http.Response{
+			httpmock.NewStringResponse(200, `{"data":{"resources":{"pageInfo":{"endCursor":"cursor-1","hasNextPage":true},"edges":[
+				{"node":{"__typename":"SSHResource","id":"ssh-1","name":"ssh-1","address":{"value":"10.0.0.1"},"remoteNetwork":{"id":"rn-1"},"gateway":{"id":"gw-1"}}},
+				{"node":{"__typename":"NetworkResource","id":"net-1","name":"net-1","address":{"value":"10.0.0.3"},"remoteNetwork":{"id":"rn-1"}}}
+			]}}}`),
+			httpmock.NewStringResponse(200, `{"data":{"resources":{"pageInfo":{"endCursor":"","hasNextPage":false},"edges":[
+				{"node":{"__typename":"SSHResource","id":"ssh-2","name":"ssh-2","address":{"value":"10.0.0.2"},"remoteNetwork":{"id":"rn-1"},"gateway":{"id":"gw-2"}}},
+				{"node":{"__typename":"KubernetesResource","id":"k8s-1","name":"k8s-1","address":{"value":"kubernetes.default.svc.cluster.local"},"remoteNetwork":{"id":"rn-1"},"gateway":{"id":"gw-1"}}}
+			]}}}`),
+		}))
+
+	sshResources, k8sResources, err := client.ReadGatewayResources(context.Background(), "gw-1")
+
+	assert.NoError(t, err)
+	assert.Len(t, sshResources, 1)
+	assert.Equal(t, "ssh-1", sshResources[0].ID)
+	assert.Equal(t, "10.0.0.1", sshResources[0].Address)
+	assert.Len(t, k8sResources, 1)
+	assert.Equal(t, "k8s-1", k8sResources[0].ID)
+	assert.Equal(t, "kubernetes.default.svc.cluster.local", k8sResources[0].Address)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestReadGatewayResourcesEmptyID(t *testing.T) {
+	client := newTestClient(t.Context())
+
+	_, _, err := client.ReadGatewayResources(context.Background(), "")
+
+	assert.ErrorIs(t, err, ErrGraphqlIDIsEmpty)
+}
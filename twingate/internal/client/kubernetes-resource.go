@@ -160,7 +160,7 @@ func (client *Client) ReadKubernetesResources(ctx context.Context) ([]*model.Kub
 
 	return utils.FilterMap(response.Edges,
 		func(edge *query.ShallowResourceEdge) bool {
-			return edge.Node.Type == "KubernetesResource"
+			return edge.Node.Type == query.TypeKubernetesResource
 		},
 		func(edge *query.ShallowResourceEdge) *model.KubernetesResource {
 			return &model.KubernetesResource{
@@ -0,0 +1,83 @@
+package query
+
+import (
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/hasura/go-graphql-client"
+)
+
+type ReadGatewayResources struct {
+	GatewayResources `graphql:"resources(after: $resourcesEndCursor, first: $pageLimit)"`
+}
+
+func (q ReadGatewayResources) IsEmpty() bool {
+	return len(q.Edges) == 0
+}
+
+type GatewayResources struct {
+	PaginatedResource[*GatewayResourceEdge]
+}
+
+type GatewayResourceEdge struct {
+	Node *gqlGatewayResource
+}
+
+type gqlGatewayResource struct {
+	Type string `graphql:"__typename"`
+	IDName
+	Address struct {
+		Value string
+	}
+	RemoteNetwork struct {
+		ID graphql.ID
+	}
+	SSHResourceFragment struct {
+		Gateway struct {
+			ID graphql.ID
+		}
+	} `graphql:"... on SSHResource"`
+	KubernetesResourceFragment struct {
+		Gateway struct {
+			ID graphql.ID
+		}
+	} `graphql:"... on KubernetesResource"`
+}
+
+// ToModel returns the SSH and Kubernetes Resources routed through the Gateway.
+func (q ReadGatewayResources) ToModel(gatewayID string) ([]*model.SSHResource, []*model.KubernetesResource) {
+	return q.GatewayResources.ToModel(func(resourceGatewayID string) bool {
+		return resourceGatewayID == gatewayID
+	})
+}
+
+// ToModel returns the SSH and Kubernetes Resources whose Gateway matches, other Resources are skipped.
+func (r GatewayResources) ToModel(matchGateway func(gatewayID string) bool) ([]*model.SSHResource, []*model.KubernetesResource) {
+	var (
+		sshResources []*model.SSHResource
+		k8sResources []*model.KubernetesResource
+	)
+
+	for _, edge := range r.Edges {
+		node := edge.Node
+
+		switch {
+		case node.Type == TypeSSHResource && matchGateway(string(node.SSHResourceFragment.Gateway.ID)):
+			sshResources = append(sshResources, &model.SSHResource{
+				ID:              string(node.ID),
+				Name:            node.Name,
+				Address:         node.Address.Value,
+				GatewayID:       string(node.SSHResourceFragment.Gateway.ID),
+				RemoteNetworkID: string(node.RemoteNetwork.ID),
+			})
+		case node.Type == TypeKubernetesResource && matchGateway(string(node.KubernetesResourceFragment.Gateway.ID)):
+			k8sResources = append(k8sResources, &model.KubernetesResource{
+				ID:              string(node.ID),
+				Name:            node.Name,
+				Address:         node.Address.Value,
+				GatewayID:       string(node.KubernetesResourceFragment.Gateway.ID),
+				RemoteNetworkID: string(node.RemoteNetwork.ID),
+			})
+		}
+	}
+
+	return sshResources, k8sResources
+}
@@ -356,6 +356,7 @@ func TestReadServiceAccountKeyToModel(t *testing.T) {
 				Name:           "service key name",
 				Service:        "service-account-id",
 				ExpirationTime: 1,
+				ExpiresAt:      today.Format(time.RFC3339),
 				Status:         "OK",
 			},
 		},
@@ -451,6 +452,7 @@ func TestUpdateServiceAccountKeyToModel(t *testing.T) {
 				Name:           "service key name",
 				Service:        "service-account-id",
 				ExpirationTime: 1,
+				ExpiresAt:      today.Format(time.RFC3339),
 				Status:         "OK",
 			},
 		},
@@ -550,6 +552,7 @@ func TestCreateServiceAccountKeyToModel(t *testing.T) {
 				Name:           "service key name",
 				Service:        "service-account-id",
 				ExpirationTime: 1,
+				ExpiresAt:      today.Format(time.RFC3339),
 				Status:         "OK",
 				Token:          "token",
 			},
@@ -5209,3 +5212,42 @@ func TestReadShallowResourcesWithTypeQueryIsEmpty(t *testing.T) {
 		})
 	}
 }
+
+func TestReadGatewayResourcesToModel(t *testing.T) {
+	gatewayNode := func(typename, id, gatewayID string) *GatewayResourceEdge {
+		node := &gqlGatewayResource{
+			Type:   typename,
+			IDName: IDName{ID: graphql.ID(id), Name: id},
+		}
+		node.Address.Value = id + ".internal"
+		node.RemoteNetwork.ID = "rn-1"
+		node.SSHResourceFragment.Gateway.ID = graphql.ID(gatewayID)
+		node.KubernetesResourceFragment.Gateway.ID = graphql.ID(gatewayID)
+
+		return &GatewayResourceEdge{Node: node}
+	}
+
+	q := ReadGatewayResources{
+		GatewayResources: GatewayResources{
+			PaginatedResource: PaginatedResource[*GatewayResourceEdge]{
+				Edges: []*GatewayResourceEdge{
+					gatewayNode(TypeSSHResource, "ssh-1", "gw-1"),
+					gatewayNode(TypeSSHResource, "ssh-2", "gw-2"),
+					gatewayNode(TypeKubernetesResource, "k8s-1", "gw-1"),
+					gatewayNode("NetworkResource", "net-1", ""),
+				},
+			},
+		},
+	}
+
+	sshResources, k8sResources := q.ToModel("gw-1")
+
+	assert.Equal(t, []*model.SSHResource{
+		{ID: "ssh-1", Name: "ssh-1", Address: "ssh-1.internal", GatewayID: "gw-1", RemoteNetworkID: "rn-1"},
+	}, sshResources)
+	assert.Equal(t, []*model.KubernetesResource{
+		{ID: "k8s-1", Name: "k8s-1", Address: "k8s-1.internal", GatewayID: "gw-1", RemoteNetworkID: "rn-1"},
+	}, k8sResources)
+	assert.False(t, q.IsEmpty())
+	assert.True(t, ReadGatewayResources{}.IsEmpty())
+}
@@ -0,0 +1,18 @@
+package query
+
+import "github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+
+type ReadRemoteNetworkGatewayResources struct {
+	GatewayResources `graphql:"resources(filter: $filter, after: $resourcesEndCursor, first: $pageLimit)"`
+}
+
+func (q ReadRemoteNetworkGatewayResources) IsEmpty() bool {
+	return len(q.Edges) == 0
+}
+
+// ToModel returns the SSH and Kubernetes Resources of the Remote Network, whichever Gateway they route through.
+func (q ReadRemoteNetworkGatewayResources) ToModel() ([]*model.SSHResource, []*model.KubernetesResource) {
+	return q.GatewayResources.ToModel(func(string) bool {
+		return true
+	})
+}
@@ -1,5 +1,10 @@
 package query
 
+const (
+	TypeSSHResource        = "SSHResource"
+	TypeKubernetesResource = "KubernetesResource"
+)
+
 type ReadShallowResourcesWithType struct {
 	ShallowResourcesWithType `graphql:"resources(after: $resourcesEndCursor, first: $pageLimit)"`
 }
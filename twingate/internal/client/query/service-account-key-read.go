@@ -41,6 +41,7 @@ func (q gqlServiceKey) ToModel() (*model.ServiceKey, error) {
 		Name:           q.Name,
 		Service:        string(q.ServiceAccount.ID),
 		ExpirationTime: expirationTime,
+		ExpiresAt:      q.ExpiresAt,
 		Status:         q.Status,
 	}, nil
 }
@@ -85,13 +85,40 @@ func (q gqlKeyIDs) listIDs() []string {
 	})
 }
 
+// ToModel returns the service account's keys, whatever their status.
+func (q gqlKeyIDs) ToModel(serviceAccountID string) ([]*model.ServiceKey, error) {
+	keys := make([]*model.ServiceKey, 0, len(q.Edges))
+
+	for _, edge := range q.Edges {
+		if edge == nil || edge.Node == nil {
+			continue
+		}
+
+		key, err := gqlServiceKey{
+			IDName:         IDName{ID: edge.Node.ID, Name: edge.Node.Name},
+			ExpiresAt:      edge.Node.ExpiresAt,
+			Status:         edge.Node.Status,
+			ServiceAccount: gqlServiceAccount{IDName: IDName{ID: graphql.ID(serviceAccountID)}},
+		}.ToModel()
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
 type GqlKeyIDEdge struct {
 	Node *gqlKeyID
 }
 
 type gqlKeyID struct {
-	ID     graphql.ID
-	Status string
+	ID        graphql.ID
+	Name      string
+	Status    string
+	ExpiresAt string
 }
 
 func (k gqlKeyID) isActive() bool {
@@ -32,6 +32,7 @@ func (u gqlUser) ToModel() *model.User {
 		Role:      u.Role,
 		Type:      u.Type,
 		IsActive:  u.State == model.UserStateActive || u.State == model.UserStatePending,
+		IsPending: u.State == model.UserStatePending,
 	}
 }
 
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client/query"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
@@ -109,6 +110,87 @@ func (client *Client) ReadRemoteNetworkByName(ctx context.Context, remoteNetwork
 	return response.RemoteNetworks.Edges[0].Node.ToModel(), nil
 }
 
+// ReadRemoteNetworkConnectors returns the Connectors of the Remote Network.
+func (client *Client) ReadRemoteNetworkConnectors(ctx context.Context, remoteNetworkID string) ([]*model.Connector, error) {
+	opr := resourceRemoteNetwork.read().withCustomName("readRemoteNetworkConnectors")
+
+	if remoteNetworkID == "" {
+		return nil, opr.apiError(ErrGraphqlNetworkIDIsEmpty)
+	}
+
+	variables := newVars(
+		gqlID(remoteNetworkID),
+		cursor(query.CursorConnectors),
+		pageLimit(client.pageLimit),
+	)
+
+	response := query.ReadRemoteNetworkConnectors{}
+	if err := client.query(ctx, &response, variables, opr, attr{id: remoteNetworkID}); err != nil {
+		return nil, err
+	}
+
+	if err := response.RemoteNetwork.Connectors.FetchPages(withOperationCtx(ctx, opr), client.readRemoteNetworkConnectorsAfter, variables); err != nil {
+		return nil, err //nolint
+	}
+
+	return response.ToModel(), nil
+}
+
+func (client *Client) readRemoteNetworkConnectorsAfter(ctx context.Context, variables map[string]any, cursor string) (*query.PaginatedResource[*query.ConnectorEdge], error) {
+	opr := resourceRemoteNetwork.read().withCustomName("readRemoteNetworkConnectorsAfter")
+
+	variables[query.CursorConnectors] = cursor
+
+	response := query.ReadRemoteNetworkConnectors{}
+	if err := client.query(ctx, &response, variables, opr, attr{id: "All"}); err != nil {
+		return nil, err
+	}
+
+	return &response.RemoteNetwork.Connectors.PaginatedResource, nil
+}
+
+// ReadRemoteNetworkGatewayResources returns the SSH and Kubernetes Resources of the Remote Network.
+func (client *Client) ReadRemoteNetworkGatewayResources(ctx context.Context, remoteNetworkID string) ([]*model.SSHResource, []*model.KubernetesResource, error) {
+	opr := resourceRemoteNetwork.read().withCustomName("readRemoteNetworkGatewayResources")
+
+	if remoteNetworkID == "" {
+		return nil, nil, opr.apiError(ErrGraphqlNetworkIDIsEmpty)
+	}
+
+	variables := newVars(
+		gqlNullable(query.NewResourceFilterInput("", "", nil, &remoteNetworkID), "filter"),
+		cursor(query.CursorResources),
+		pageLimit(client.pageLimit),
+	)
+
+	response := query.ReadRemoteNetworkGatewayResources{}
+	if err := client.query(ctx, &response, variables, opr, attr{id: remoteNetworkID}); err != nil && !errors.Is(err, ErrGraphqlResultIsEmpty) {
+		return nil, nil, err
+	}
+
+	if err := response.FetchPages(withOperationCtx(ctx, opr), client.readRemoteNetworkGatewayResourcesAfter, variables); err != nil {
+		return nil, nil, err //nolint
+	}
+
+	sshResources, k8sResources := response.ToModel()
+
+	return sshResources, k8sResources, nil
+}
+
+func (client *Client) readRemoteNetworkGatewayResourcesAfter(ctx context.Context, variables map[string]any, cursor string) (*query.PaginatedResource[*query.GatewayResourceEdge], error) {
+	opr := resourceRemoteNetwork.read().withCustomName("readRemoteNetworkGatewayResourcesAfter")
+
+	variables[query.CursorResources] = cursor
+
+	response := query.ReadRemoteNetworkGatewayResources{}
+	if err := client.query(ctx, &response, variables, opr, attr{id: "All"}); err != nil {
+		return nil, err
+	}
+
+	//nolint:staticcheck
+	return &response.GatewayResources.PaginatedResource, nil
+}
+
 func (client *Client) UpdateRemoteNetwork(ctx context.Context, req *model.RemoteNetwork) (*model.RemoteNetwork, error) {
 	opr := resourceRemoteNetwork.update()
 
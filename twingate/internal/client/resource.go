@@ -488,6 +488,22 @@ func (client *Client) UpdateResourceActiveState(ctx context.Context, resource *m
 	return client.mutate(ctx, &response, variables, opr, attr{id: resource.ID})
 }
 
+// ReadRemoteNetworkResources returns the Resources in the Remote Network, from the cache when it holds every Resource.
+func (client *Client) ReadRemoteNetworkResources(ctx context.Context, remoteNetworkID string) ([]*model.Resource, error) {
+	filter := &model.ResourcesFilter{RemoteNetworkID: &remoteNetworkID}
+
+	if isCacheComplete[*model.Resource]() {
+		return matchResources[*model.Resource](filter), nil
+	}
+
+	resources, err := client.ReadResourcesByName(ctx, filter)
+	if err != nil && !errors.Is(err, ErrGraphqlResultIsEmpty) {
+		return nil, err
+	}
+
+	return resources, nil
+}
+
 func (client *Client) ReadResourcesByName(ctx context.Context, filter *model.ResourcesFilter) ([]*model.Resource, error) {
 	opr := resourceResource.read().withCustomName("readResourcesByName")
 
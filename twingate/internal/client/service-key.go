@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client/query"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
@@ -45,6 +46,63 @@ func (client *Client) ReadServiceKey(ctx context.Context, serviceAccountKeyID st
 	return response.ToModel() //nolint
 }
 
+// ReadServiceKeys returns the keys of the service account, or of every service account when serviceAccountID is empty.
+func (client *Client) ReadServiceKeys(ctx context.Context, serviceAccountID string) ([]*model.ServiceKey, error) {
+	if serviceAccountID != "" {
+		return client.readServiceAccountKeys(ctx, serviceAccountID)
+	}
+
+	accounts, err := client.ReadShallowServiceAccounts(ctx)
+	if err != nil && !errors.Is(err, ErrGraphqlResultIsEmpty) {
+		return nil, err
+	}
+
+	var keys []*model.ServiceKey
+
+	for _, account := range accounts {
+		accountKeys, err := client.readServiceAccountKeys(ctx, account.ID)
+		// an account removed since it was listed has no keys to report
+		if err != nil && !errors.Is(err, ErrGraphqlResultIsEmpty) {
+			return nil, err
+		}
+
+		keys = append(keys, accountKeys...)
+	}
+
+	return keys, nil
+}
+
+func (client *Client) readServiceAccountKeys(ctx context.Context, serviceAccountID string) ([]*model.ServiceKey, error) {
+	opr := resourceServiceKey.read().withCustomName("readServiceAccountKeys")
+
+	variables := newVars(
+		gqlID(serviceAccountID),
+		cursor(query.CursorResources),
+		cursor(query.CursorServiceKeys),
+		pageLimit(client.pageLimit),
+	)
+
+	response := query.ReadServiceAccount{}
+	if err := client.query(ctx, &response, variables, opr, attr{id: serviceAccountID}); err != nil {
+		// an account without keys or resources is reported as empty too, only a missing one is not found
+		if !errors.Is(err, ErrGraphqlResultIsEmpty) || response.Service == nil {
+			return nil, err
+		}
+	}
+
+	err := response.Service.Keys.FetchPages(withOperationCtx(ctx, opr), client.readServiceKeysAfter, newVars(gqlID(serviceAccountID), pageLimit(client.pageLimit)))
+	if err != nil {
+		return nil, err //nolint
+	}
+
+	keys, err := response.Service.Keys.ToModel(serviceAccountID)
+	if err != nil {
+		return nil, opr.apiError(err, attr{id: serviceAccountID})
+	}
+
+	return keys, nil
+}
+
 func (client *Client) UpdateServiceKey(ctx context.Context, serviceAccountKey *model.ServiceKey) (*model.ServiceKey, error) {
 	opr := resourceServiceKey.update()
 
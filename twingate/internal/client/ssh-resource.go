@@ -145,7 +145,7 @@ func (client *Client) ReadSSHResources(ctx context.Context) ([]*model.SSHResourc
 
 	return utils.FilterMap(response.Edges,
 		func(edge *query.ShallowResourceEdge) bool {
-			return edge.Node.Type == "SSHResource"
+			return edge.Node.Type == query.TypeSSHResource
 		},
 		func(edge *query.ShallowResourceEdge) *model.SSHResource {
 			return &model.SSHResource{
@@ -2,7 +2,6 @@ package customvalidator
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
@@ -39,12 +38,18 @@ func (ca certificateValidator) ValidateString(ctx context.Context, req validator
 		return
 	}
 
-	_, err := utils.CalculateCertificateFingerprint(certValue.ValueString())
+	// only structural checks, so that an expiring certificate already in use doesn't make the configuration invalid,
+	// expiry is checked at plan time
+	certs, err := utils.ParseCertificates(certValue.ValueString())
+	if err == nil {
+		err = utils.ValidateCACertificateUsage(certs)
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddAttributeError(
 			path.Root(attr.Certificate),
 			"Invalid certificate",
-			fmt.Sprintf("Could not calculate fingerprint: %s", err),
+			err.Error(),
 		)
 
 		return
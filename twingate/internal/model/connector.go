@@ -1,6 +1,9 @@
 package model
 
-import "github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+import (
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/hashicorp/go-version"
+)
 
 type Connector struct {
 	ID                   string
@@ -35,3 +38,46 @@ func (c Connector) ToTerraform() any {
 		attr.PrivateIPs:           c.PrivateIPs,
 	}
 }
+
+func (c Connector) FilterValue(field, _ string) (string, bool) {
+	switch field {
+	case attr.Name:
+		return c.Name, true
+	case attr.Hostname:
+		return c.Hostname, true
+	case attr.Version:
+		return c.Version, true
+	case attr.State:
+		return c.State, true
+	}
+
+	return "", false
+}
+
+const (
+	ConnectorStateAlive               = "ALIVE"
+	ConnectorStateDeadNoHeartbeat     = "DEAD_NO_HEARTBEAT"
+	ConnectorStateDeadHeartbeatTooOld = "DEAD_HEARTBEAT_TOO_OLD"
+	ConnectorStateDeadNoRelays        = "DEAD_NO_RELAYS"
+)
+
+//nolint:gochecknoglobals
+var ConnectorStates = []string{ConnectorStateAlive, ConnectorStateDeadNoHeartbeat, ConnectorStateDeadHeartbeatTooOld, ConnectorStateDeadNoRelays}
+
+// IsReady reports whether the Connector is alive and, when minVersion is set, runs at least minVersion.
+func (c Connector) IsReady(minVersion *version.Version) bool {
+	if c.State != ConnectorStateAlive {
+		return false
+	}
+
+	if minVersion == nil {
+		return true
+	}
+
+	current, err := version.NewVersion(c.Version)
+	if err != nil {
+		return false
+	}
+
+	return current.GreaterThanOrEqual(minVersion)
+}
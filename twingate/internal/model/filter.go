@@ -0,0 +1,128 @@
+package model
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+)
+
+const (
+	FilterOperatorEquals   = "equals"
+	FilterOperatorContains = "contains"
+	FilterOperatorPrefix   = "prefix"
+	FilterOperatorSuffix   = "suffix"
+	FilterOperatorRegexp   = "regexp"
+
+	FilterMatchAll = "all"
+	FilterMatchAny = "any"
+)
+
+//nolint:gochecknoglobals
+var (
+	FilterOperators = []string{FilterOperatorEquals, FilterOperatorContains, FilterOperatorPrefix, FilterOperatorSuffix, FilterOperatorRegexp}
+	FilterMatches   = []string{FilterMatchAll, FilterMatchAny}
+)
+
+// Filterable is implemented by the objects a FilterExpression can be evaluated against.
+type Filterable interface {
+	// FilterValue returns the value of the field, and false if the object doesn't have it.
+	// The key is only used by map fields, e.g. the value of a tag.
+	FilterValue(field, key string) (string, bool)
+}
+
+// FilterCondition tests a single field of an object. Objects without the field don't match,
+// unless the condition is negated.
+type FilterCondition struct {
+	Field    string
+	Key      string
+	Operator string
+	Value    string
+	Negate   bool
+	// Pattern is the compiled Value of regexp conditions.
+	Pattern *regexp.Regexp
+}
+
+func (c FilterCondition) Match(item Filterable) bool {
+	value, ok := item.FilterValue(c.Field, c.Key)
+
+	return (ok && c.matchValue(value)) != c.Negate
+}
+
+func (c FilterCondition) matchValue(value string) bool {
+	switch c.Operator {
+	case FilterOperatorContains:
+		return strings.Contains(value, c.Value)
+	case FilterOperatorPrefix:
+		return strings.HasPrefix(value, c.Value)
+	case FilterOperatorSuffix:
+		return strings.HasSuffix(value, c.Value)
+	case FilterOperatorRegexp:
+		return c.Pattern != nil && c.Pattern.MatchString(value)
+	default:
+		return value == c.Value
+	}
+}
+
+// filterBy returns the name filter suffix used by the API queries for the condition's operator.
+func (c FilterCondition) filterBy() string {
+	switch c.Operator {
+	case FilterOperatorContains:
+		return attr.FilterByContains
+	case FilterOperatorPrefix:
+		return attr.FilterByPrefix
+	case FilterOperatorSuffix:
+		return attr.FilterBySuffix
+	case FilterOperatorRegexp:
+		return attr.FilterByRegexp
+	default:
+		return ""
+	}
+}
+
+// FilterGroup combines its conditions with AND, or with OR when MatchAny is set.
+type FilterGroup struct {
+	MatchAny   bool
+	Conditions []FilterCondition
+}
+
+func (g FilterGroup) Match(item Filterable) bool {
+	for _, condition := range g.Conditions {
+		if condition.Match(item) == g.MatchAny {
+			return g.MatchAny
+		}
+	}
+
+	return !g.MatchAny
+}
+
+// FilterExpression combines its groups with AND. An empty expression matches everything.
+type FilterExpression []FilterGroup
+
+func (e FilterExpression) Match(item Filterable) bool {
+	for _, group := range e {
+		if !group.Match(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PushDown returns a condition on the field that every match must satisfy, as the value and
+// name filter suffix the API queries accept. The value is empty when there's no such condition.
+func (e FilterExpression) PushDown(field string) (string, string) {
+	for _, group := range e {
+		if group.MatchAny && len(group.Conditions) > 1 {
+			continue
+		}
+
+		for _, condition := range group.Conditions {
+			if condition.Field == field && !condition.Negate && condition.Value != "" {
+				return condition.Value, condition.filterBy()
+			}
+		}
+	}
+
+	return "", ""
+}
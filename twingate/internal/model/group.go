@@ -101,6 +101,17 @@ func (g Group) Match(filter ResourceFilter) bool {
 	return true
 }
 
+func (g Group) FilterValue(field, _ string) (string, bool) {
+	switch field {
+	case attr.Name:
+		return g.Name, true
+	case attr.Type:
+		return g.Type, true
+	}
+
+	return "", false
+}
+
 type GroupsFilter struct {
 	Name       *string
 	NameFilter string
@@ -37,3 +37,16 @@ func (n RemoteNetwork) ToTerraform() any {
 		attr.Location: n.Location,
 	}
 }
+
+func (n RemoteNetwork) FilterValue(field, _ string) (string, bool) {
+	switch field {
+	case attr.Name:
+		return n.Name, true
+	case attr.Location:
+		return n.Location, true
+	case attr.Type:
+		return n.Type, true
+	}
+
+	return "", false
+}
@@ -278,6 +278,21 @@ func (r Resource) Match(filter ResourceFilter) bool {
 	return true
 }
 
+func (r Resource) FilterValue(field, key string) (string, bool) {
+	switch field {
+	case attr.Name:
+		return r.Name, true
+	case attr.Address:
+		return r.Address, true
+	case attr.Tag:
+		value, ok := r.Tags[key]
+
+		return value, ok
+	}
+
+	return "", false
+}
+
 type PortRange struct {
 	Start int
 	End   int
@@ -13,3 +13,11 @@ func (s SecurityPolicy) ToTerraform() any {
 		attr.Name: s.Name,
 	}
 }
+
+func (s SecurityPolicy) FilterValue(field, _ string) (string, bool) {
+	if field == attr.Name {
+		return s.Name, true
+	}
+
+	return "", false
+}
@@ -11,6 +11,7 @@ type ServiceKey struct {
 	Status         string
 	Service        string
 	ExpirationTime int
+	ExpiresAt      string
 	Token          string
 }
 
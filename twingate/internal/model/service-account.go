@@ -25,3 +25,11 @@ func (s ServiceAccount) ToTerraform() any {
 		attr.KeyIDs:      s.Keys,
 	}
 }
+
+func (s ServiceAccount) FilterValue(field, _ string) (string, bool) {
+	if field == attr.Name {
+		return s.Name, true
+	}
+
+	return "", false
+}
@@ -21,8 +21,9 @@ const DefaultUserRole = UserRoleMember
 
 //nolint:gochecknoglobals
 var (
-	UserRoles = []string{UserRoleAdmin, UserRoleDevops, UserRoleSupport, UserRoleMember, UserRoleAccessReviewer}
-	UserTypes = []string{UserTypeManual, UserTypeSynced}
+	UserRoles  = []string{UserRoleAdmin, UserRoleDevops, UserRoleSupport, UserRoleMember, UserRoleAccessReviewer}
+	UserTypes  = []string{UserTypeManual, UserTypeSynced}
+	UserStates = []string{UserStateActive, UserStatePending, UserStateDisabled}
 )
 
 type User struct {
@@ -33,6 +34,7 @@ type User struct {
 	Role      string
 	Type      string
 	IsActive  bool
+	IsPending bool
 }
 
 func (u User) GetID() string {
@@ -56,6 +58,10 @@ func (u User) ToTerraform() any {
 }
 
 func (u User) State() string {
+	if u.IsPending {
+		return UserStatePending
+	}
+
 	if u.IsActive {
 		return UserStateActive
 	}
@@ -63,6 +69,25 @@ func (u User) State() string {
 	return UserStateDisabled
 }
 
+func (u User) FilterValue(field, _ string) (string, bool) {
+	switch field {
+	case attr.Email:
+		return u.Email, true
+	case attr.FirstName:
+		return u.FirstName, true
+	case attr.LastName:
+		return u.LastName, true
+	case attr.Role:
+		return u.Role, true
+	case attr.Type:
+		return u.Type, true
+	case attr.State:
+		return u.State(), true
+	}
+
+	return "", false
+}
+
 type UserUpdate struct {
 	ID        string
 	FirstName *string
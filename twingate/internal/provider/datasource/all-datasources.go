@@ -9,16 +9,20 @@ const (
 	TwingateUsers                    = "twingate_users"
 	TwingateConnector                = "twingate_connector"
 	TwingateConnectors               = "twingate_connectors"
+	TwingateConnectorStatus          = "twingate_connector_status"
 	TwingateResource                 = "twingate_resource"
 	TwingateResources                = "twingate_resources"
 	TwingateServiceAccounts          = "twingate_service_accounts"
+	TwingateServiceAccountKeys       = "twingate_service_account_keys"
 	TwingateSecurityPolicy           = "twingate_security_policy" // #nosec G101
 	TwingateSecurityPolicies         = "twingate_security_policies"
 	TwingateDNSFilteringProfile      = "twingate_dns_filtering_profile"
+	TwingateDNSFilteringProfiles     = "twingate_dns_filtering_profiles"
 	TwingateX509CertificateAuthority = "twingate_x509_certificate_authority"
 	TwingateSSHCertificateAuthority  = "twingate_ssh_certificate_authority"
 	TwingateGateway                  = "twingate_gateway"
 	TwingateSyncToS3                 = "twingate_sync_to_s3"
+	TwingateEffectiveAccess          = "twingate_effective_access"
 
 	computedDatasourceIDDescription = "The ID of this resource."
 
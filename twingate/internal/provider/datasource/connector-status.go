@@ -0,0 +1,248 @@
+package datasource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultConnectorStatusTimeout = 10 * time.Minute
+
+var ErrConnectorsNotReady = errors.New("connectors are not ready")
+
+// connectorStatusPollInterval is a variable so tests can poll faster.
+var connectorStatusPollInterval = 10 * time.Second //nolint:gochecknoglobals
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSource = &connectorStatus{}
+
+func NewConnectorStatusDatasource() datasource.DataSource {
+	return &connectorStatus{}
+}
+
+type connectorStatus struct {
+	client *client.Client
+}
+
+type connectorStatusModel struct {
+	ID              types.String               `tfsdk:"id"`
+	RemoteNetworkID types.String               `tfsdk:"remote_network_id"`
+	MinVersion      types.String               `tfsdk:"min_version"`
+	Wait            types.Bool                 `tfsdk:"wait"`
+	Ready           types.Bool                 `tfsdk:"ready"`
+	Connectors      []connectorStatusItemModel `tfsdk:"connectors"`
+	Timeouts        timeouts.Value             `tfsdk:"timeouts"`
+}
+
+type connectorStatusItemModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	State   types.String `tfsdk:"state"`
+	Version types.String `tfsdk:"version"`
+	Ready   types.Bool   `tfsdk:"ready"`
+}
+
+func (d *connectorStatus) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = TwingateConnectorStatus
+}
+
+func (d *connectorStatus) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *connectorStatus) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Waits until all Connectors in a Remote Network are `%s` and, optionally, run at least a minimum version. Use it to sequence a blue/green Connector replacement: resources depending on this data source are only changed once the new Connectors are healthy. Waiting is bounded by the `read` timeout, %s by default.", model.ConnectorStateAlive, defaultConnectorStatusTimeout),
+		Attributes: map[string]schema.Attribute{
+			attr.ID: schema.StringAttribute{
+				Computed:    true,
+				Description: computedDatasourceIDDescription,
+			},
+			attr.RemoteNetworkID: schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Remote Network whose Connectors are checked.",
+			},
+			attr.MinVersion: schema.StringAttribute{
+				Optional:    true,
+				Description: "The minimum version every Connector must run, e.g. `1.62.0`.",
+			},
+			attr.Wait: schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to wait until the Connectors are ready and fail when they aren't within the `read` timeout. When `false`, the current status is returned as is. Default is `true`.",
+			},
+			// computed
+			attr.Ready: schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the Remote Network has Connectors and all of them are ready.",
+			},
+			attr.Connectors: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of Connectors in the Remote Network",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.ID: schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Connector.",
+						},
+						attr.Name: schema.StringAttribute{
+							Computed:    true,
+							Description: "The Name of the Connector.",
+						},
+						attr.State: schema.StringAttribute{
+							Computed:    true,
+							Description: "The Connector's state. One of `ALIVE`, `DEAD_NO_HEARTBEAT`, `DEAD_HEARTBEAT_TOO_OLD` or `DEAD_NO_RELAYS`.",
+						},
+						attr.Version: schema.StringAttribute{
+							Computed:    true,
+							Description: "The Connector's version.",
+						},
+						attr.Ready: schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the Connector is alive and runs at least `min_version`.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			attr.Timeouts: timeouts.Block(ctx),
+		},
+	}
+}
+
+func (d *connectorStatus) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data connectorStatusModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var minVersion *version.Version
+
+	if data.MinVersion.ValueString() != "" {
+		var err error
+
+		minVersion, err = version.NewVersion(data.MinVersion.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attr.MinVersion), "Invalid Attribute Value", err.Error())
+
+			return
+		}
+	}
+
+	timeout, diags := data.Timeouts.Read(ctx, defaultConnectorStatusTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	networkID := data.RemoteNetworkID.ValueString()
+	wait := data.Wait.IsNull() || data.Wait.ValueBool()
+
+	var connectors []*model.Connector
+
+	err := utils.Poll(ctx, connectorStatusPollInterval, func(ctx context.Context) (bool, error) {
+		all, err := d.client.ReadConnectors(ctx, "", "")
+		if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+			return false, err //nolint:wrapcheck
+		}
+
+		connectors = utils.Filter(all, func(conn *model.Connector) bool {
+			return conn.NetworkID == networkID
+		})
+
+		return !wait || connectorsReady(connectors, minVersion), nil
+	})
+
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("%w after %s:\n%s", ErrConnectorsNotReady, timeout, describeConnectorsNotReady(connectors, minVersion))
+	}
+
+	if err != nil {
+		addErr(&resp.Diagnostics, err, TwingateConnectorStatus)
+
+		return
+	}
+
+	data.ID = types.StringValue(networkID)
+	data.Ready = types.BoolValue(connectorsReady(connectors, minVersion))
+	data.Connectors = utils.Map(connectors, func(conn *model.Connector) connectorStatusItemModel {
+		return connectorStatusItemModel{
+			ID:      types.StringValue(conn.ID),
+			Name:    types.StringValue(conn.Name),
+			State:   types.StringValue(conn.State),
+			Version: types.StringValue(conn.Version),
+			Ready:   types.BoolValue(conn.IsReady(minVersion)),
+		}
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// connectorsReady reports whether there are Connectors and all of them are ready.
+func connectorsReady(connectors []*model.Connector, minVersion *version.Version) bool {
+	if len(connectors) == 0 {
+		return false
+	}
+
+	for _, conn := range connectors {
+		if !conn.IsReady(minVersion) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func describeConnectorsNotReady(connectors []*model.Connector, minVersion *version.Version) string {
+	if len(connectors) == 0 {
+		return "- the Remote Network has no Connectors"
+	}
+
+	lines := utils.FilterMap(connectors,
+		func(conn *model.Connector) bool {
+			return !conn.IsReady(minVersion)
+		},
+		func(conn *model.Connector) string {
+			return fmt.Sprintf("- %s (%s): state %q, version %q", conn.Name, conn.ID, conn.State, conn.Version)
+		},
+	)
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,47 @@
+package datasource
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/hashicorp/go-version"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectorsReady(t *testing.T) {
+	minVersion := version.Must(version.NewVersion("1.62.0"))
+
+	cases := []struct {
+		connectors []*model.Connector
+		minVersion *version.Version
+		expected   bool
+	}{
+		{connectors: nil, expected: false},
+		{connectors: []*model.Connector{{State: "ALIVE", Version: ""}}, expected: true},
+		{connectors: []*model.Connector{{State: "ALIVE"}, {State: "DEAD_NO_HEARTBEAT"}}, expected: false},
+		{connectors: []*model.Connector{{State: "ALIVE", Version: "1.62.0"}, {State: "ALIVE", Version: "1.70.1"}}, minVersion: minVersion, expected: true},
+		{connectors: []*model.Connector{{State: "ALIVE", Version: "1.61.9"}}, minVersion: minVersion, expected: false},
+		{connectors: []*model.Connector{{State: "ALIVE", Version: ""}}, minVersion: minVersion, expected: false},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, connectorsReady(c.connectors, c.minVersion))
+		})
+	}
+}
+
+func TestDescribeConnectorsNotReady(t *testing.T) {
+	minVersion := version.Must(version.NewVersion("1.62.0"))
+
+	assert.Equal(t, "- the Remote Network has no Connectors", describeConnectorsNotReady(nil, minVersion))
+
+	connectors := []*model.Connector{
+		{ID: "id-1", Name: "blue", State: "ALIVE", Version: "1.61.0"},
+		{ID: "id-2", Name: "green", State: "ALIVE", Version: "1.62.0"},
+		{ID: "id-3", Name: "new", State: "DEAD_NO_HEARTBEAT"},
+	}
+
+	assert.Equal(t, "- blue (id-1): state \"ALIVE\", version \"1.61.0\"\n- new (id-3): state \"DEAD_NO_HEARTBEAT\", version \"\"", describeConnectorsNotReady(connectors, minVersion))
+}
@@ -7,9 +7,15 @@ import (
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -27,14 +33,21 @@ type connectors struct {
 }
 
 type connectorsModel struct {
-	ID           types.String     `tfsdk:"id"`
-	Name         types.String     `tfsdk:"name"`
-	NameRegexp   types.String     `tfsdk:"name_regexp"`
-	NameContains types.String     `tfsdk:"name_contains"`
-	NameExclude  types.String     `tfsdk:"name_exclude"`
-	NamePrefix   types.String     `tfsdk:"name_prefix"`
-	NameSuffix   types.String     `tfsdk:"name_suffix"`
-	Connectors   []connectorModel `tfsdk:"connectors"`
+	ID                   types.String     `tfsdk:"id"`
+	Name                 types.String     `tfsdk:"name"`
+	NameRegexp           types.String     `tfsdk:"name_regexp"`
+	NameContains         types.String     `tfsdk:"name_contains"`
+	NameExclude          types.String     `tfsdk:"name_exclude"`
+	NamePrefix           types.String     `tfsdk:"name_prefix"`
+	NameSuffix           types.String     `tfsdk:"name_suffix"`
+	RemoteNetworkID      types.String     `tfsdk:"remote_network_id"`
+	State                types.String     `tfsdk:"state"`
+	VersionLessThan      types.String     `tfsdk:"version_less_than"`
+	StatusUpdatesEnabled types.Bool       `tfsdk:"status_updates_enabled"`
+	Connectors           []connectorModel `tfsdk:"connectors"`
+	StateCounts          types.Map        `tfsdk:"state_counts"`
+	OldestVersion        types.String     `tfsdk:"oldest_version"`
+	Filter               []filterModel    `tfsdk:"filter"`
 }
 
 func (d *connectors) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -93,8 +106,36 @@ func (d *connectors) Schema(ctx context.Context, req datasource.SchemaRequest, r
 				Optional:    true,
 				Description: "The name of the connector must end with the value.",
 			},
+			attr.RemoteNetworkID: schema.StringAttribute{
+				Optional:    true,
+				Description: "Returns only Connectors in this Remote Network.",
+			},
+			attr.State: schema.StringAttribute{
+				Optional:    true,
+				Description: "Returns only Connectors in this state. One of `ALIVE`, `DEAD_NO_HEARTBEAT`, `DEAD_HEARTBEAT_TOO_OLD` or `DEAD_NO_RELAYS`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.ConnectorStates...),
+				},
+			},
+			attr.VersionLessThan: schema.StringAttribute{
+				Optional:    true,
+				Description: "Returns only Connectors running a version older than this semantic version, e.g. `1.60.0`. Connectors without a valid version are excluded.",
+			},
+			attr.StatusUpdatesEnabled: schema.BoolAttribute{
+				Optional:    true,
+				Description: "Returns only Connectors with status notifications enabled (`true`) or disabled (`false`).",
+			},
 
 			// computed
+			attr.StateCounts: schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "The number of returned Connectors per state. Every state is present, with `0` when no Connector is in it.",
+			},
+			attr.OldestVersion: schema.StringAttribute{
+				Computed:    true,
+				Description: "The oldest version among the returned Connectors, or empty when none reports a valid version.",
+			},
 			attr.Connectors: schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of Connectors",
@@ -141,6 +182,9 @@ func (d *connectors) Schema(ctx context.Context, req datasource.SchemaRequest, r
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			attr.Filter: filterBlock(attr.Name, attr.Hostname, attr.Version, attr.State),
+		},
 	}
 }
 
@@ -162,6 +206,24 @@ func (d *connectors) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
+	expression, err := buildFilterExpression(data.Filter)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, TwingateConnectors)
+
+		return
+	}
+
+	if name == "" {
+		name, filter = expression.PushDown(attr.Name)
+	}
+
+	query, err := newConnectorsQuery(&data)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(attr.VersionLessThan), "Invalid Attribute Value", err.Error())
+
+		return
+	}
+
 	connectors, err := d.client.ReadConnectors(ctx, name, filter)
 	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
 		addErr(&resp.Diagnostics, err, TwingateConnectors)
@@ -169,9 +231,104 @@ func (d *connectors) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
+	connectors = utils.Filter(applyFilter(connectors, expression), query.match)
+
+	stateCounts, diags := types.MapValueFrom(ctx, types.Int64Type, countConnectorStates(connectors))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	data.ID = types.StringValue("all-connectors")
 	data.Connectors = convertConnectorsToTerraform(connectors)
+	data.StateCounts = stateCounts
+	data.OldestVersion = types.StringValue(oldestConnectorVersion(connectors))
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// connectorsQuery holds the filters applied to the Connectors returned by the API.
+type connectorsQuery struct {
+	remoteNetworkID      string
+	state                string
+	versionLessThan      *version.Version
+	statusUpdatesEnabled *bool
+}
+
+func newConnectorsQuery(data *connectorsModel) (*connectorsQuery, error) {
+	query := &connectorsQuery{
+		remoteNetworkID:      data.RemoteNetworkID.ValueString(),
+		state:                data.State.ValueString(),
+		statusUpdatesEnabled: data.StatusUpdatesEnabled.ValueBoolPointer(),
+	}
+
+	if data.VersionLessThan.ValueString() != "" {
+		maxVersion, err := version.NewVersion(data.VersionLessThan.ValueString())
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		query.versionLessThan = maxVersion
+	}
+
+	return query, nil
+}
+
+func (q *connectorsQuery) match(connector *model.Connector) bool {
+	if q.remoteNetworkID != "" && connector.NetworkID != q.remoteNetworkID {
+		return false
+	}
+
+	if q.state != "" && connector.State != q.state {
+		return false
+	}
+
+	if q.statusUpdatesEnabled != nil &&
+		(connector.StatusUpdatesEnabled == nil || *connector.StatusUpdatesEnabled != *q.statusUpdatesEnabled) {
+		return false
+	}
+
+	if q.versionLessThan != nil {
+		current, err := version.NewVersion(connector.Version)
+		if err != nil || !current.LessThan(q.versionLessThan) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func countConnectorStates(connectors []*model.Connector) map[string]int64 {
+	counts := make(map[string]int64, len(model.ConnectorStates))
+	for _, state := range model.ConnectorStates {
+		counts[state] = 0
+	}
+
+	for _, connector := range connectors {
+		counts[connector.State]++
+	}
+
+	return counts
+}
+
+// oldestConnectorVersion returns the lowest valid version reported by the Connectors, as reported.
+func oldestConnectorVersion(connectors []*model.Connector) string {
+	var oldest *version.Version
+
+	var oldestRaw string
+
+	for _, connector := range connectors {
+		current, err := version.NewVersion(connector.Version)
+		if err != nil {
+			continue
+		}
+
+		if oldest == nil || current.LessThan(oldest) {
+			oldest, oldestRaw = current, connector.Version
+		}
+	}
+
+	return oldestRaw
+}
@@ -0,0 +1,98 @@
+package datasource
+
+import (
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectorsQuery(t *testing.T) {
+	enabled, disabled := true, false
+
+	connectors := []*model.Connector{
+		{ID: "alive", NetworkID: "net-1", State: model.ConnectorStateAlive, Version: "1.62.0", StatusUpdatesEnabled: &enabled},
+		{ID: "old", NetworkID: "net-1", State: model.ConnectorStateAlive, Version: "1.9.3", StatusUpdatesEnabled: &disabled},
+		{ID: "dead", NetworkID: "net-2", State: model.ConnectorStateDeadNoHeartbeat, Version: "1.58.1", StatusUpdatesEnabled: &enabled},
+		{ID: "unknown", NetworkID: "net-2", State: model.ConnectorStateDeadNoRelays, StatusUpdatesEnabled: &enabled},
+	}
+
+	cases := []struct {
+		name     string
+		data     connectorsModel
+		expected []string
+	}{
+		{
+			name:     "no filters",
+			expected: []string{"alive", "old", "dead", "unknown"},
+		},
+		{
+			name:     "remote network",
+			data:     connectorsModel{RemoteNetworkID: types.StringValue("net-2")},
+			expected: []string{"dead", "unknown"},
+		},
+		{
+			name:     "state",
+			data:     connectorsModel{State: types.StringValue(model.ConnectorStateAlive)},
+			expected: []string{"alive", "old"},
+		},
+		{
+			name:     "version less than uses semantic versioning",
+			data:     connectorsModel{VersionLessThan: types.StringValue("1.60.0")},
+			expected: []string{"old", "dead"},
+		},
+		{
+			name:     "status updates disabled",
+			data:     connectorsModel{StatusUpdatesEnabled: types.BoolValue(false)},
+			expected: []string{"old"},
+		},
+		{
+			name: "combined",
+			data: connectorsModel{
+				RemoteNetworkID: types.StringValue("net-1"),
+				VersionLessThan: types.StringValue("2.0.0"),
+			},
+			expected: []string{"alive", "old"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			query, err := newConnectorsQuery(&c.data)
+			assert.NoError(t, err)
+
+			ids := utils.Map(utils.Filter(connectors, query.match), func(connector *model.Connector) string {
+				return connector.ID
+			})
+
+			assert.Equal(t, c.expected, ids)
+		})
+	}
+}
+
+func TestConnectorsQueryInvalidVersion(t *testing.T) {
+	_, err := newConnectorsQuery(&connectorsModel{VersionLessThan: types.StringValue("latest")})
+
+	assert.Error(t, err)
+}
+
+func TestConnectorsAggregates(t *testing.T) {
+	connectors := []*model.Connector{
+		{State: model.ConnectorStateAlive, Version: "1.62.0"},
+		{State: model.ConnectorStateAlive, Version: "1.9.3"},
+		{State: model.ConnectorStateDeadHeartbeatTooOld, Version: "v1.10.0"},
+		{State: model.ConnectorStateDeadNoRelays},
+	}
+
+	assert.Equal(t, map[string]int64{
+		model.ConnectorStateAlive:               2,
+		model.ConnectorStateDeadNoHeartbeat:     0,
+		model.ConnectorStateDeadHeartbeatTooOld: 1,
+		model.ConnectorStateDeadNoRelays:        1,
+	}, countConnectorStates(connectors))
+
+	assert.Equal(t, "1.9.3", oldestConnectorVersion(connectors))
+	assert.Empty(t, oldestConnectorVersion(nil))
+}
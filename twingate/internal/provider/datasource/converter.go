@@ -58,6 +58,7 @@ func convertUsersToTerraform(users []*model.User) []userModel {
 			Email:     types.StringValue(user.Email),
 			Role:      types.StringValue(user.Role),
 			Type:      types.StringValue(user.Type),
+			State:     types.StringValue(user.State()),
 		}
 	})
 }
@@ -104,7 +104,7 @@ func TestConverterUsersToTerraform(t *testing.T) {
 		},
 		{
 			input: []*model.User{
-				{ID: "user-id", FirstName: "Name", LastName: "Last", Email: "user@email.com", Role: "USER", Type: "SYNCED"},
+				{ID: "user-id", FirstName: "Name", LastName: "Last", Email: "user@email.com", Role: "USER", Type: "SYNCED", IsActive: true},
 				{ID: "admin-id", FirstName: "Admin", LastName: "Last", Email: "admin@email.com", Role: model.UserRoleAdmin, Type: "MANUAL"},
 			},
 			expected: []userModel{
@@ -115,6 +115,7 @@ func TestConverterUsersToTerraform(t *testing.T) {
 					Email:     types.StringValue("user@email.com"),
 					Role:      types.StringValue("USER"),
 					Type:      types.StringValue("SYNCED"),
+					State:     types.StringValue(model.UserStateActive),
 				},
 				{
 					ID:        types.StringValue("admin-id"),
@@ -123,6 +124,7 @@ func TestConverterUsersToTerraform(t *testing.T) {
 					Email:     types.StringValue("admin@email.com"),
 					Role:      types.StringValue(model.UserRoleAdmin),
 					Type:      types.StringValue("MANUAL"),
+					State:     types.StringValue(model.UserStateDisabled),
 				},
 			},
 		},
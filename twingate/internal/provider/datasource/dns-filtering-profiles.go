@@ -0,0 +1,143 @@
+package datasource
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSource = &dnsFilteringProfiles{}
+
+func NewDNSFilteringProfilesDatasource() datasource.DataSource {
+	return &dnsFilteringProfiles{}
+}
+
+type dnsFilteringProfiles struct {
+	client *client.Client
+}
+
+type dnsFilteringProfilesModel struct {
+	ID       types.String                   `tfsdk:"id"`
+	Profiles []dnsFilteringProfileItemModel `tfsdk:"dns_filtering_profiles"`
+}
+
+type dnsFilteringProfileItemModel struct {
+	ID             types.String  `tfsdk:"id"`
+	Name           types.String  `tfsdk:"name"`
+	Priority       types.Float64 `tfsdk:"priority"`
+	FallbackMethod types.String  `tfsdk:"fallback_method"`
+	GroupCount     types.Int64   `tfsdk:"group_count"`
+}
+
+func (d *dnsFilteringProfiles) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = TwingateDNSFilteringProfiles
+}
+
+func (d *dnsFilteringProfiles) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *dnsFilteringProfiles) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "DNS filtering gives you the ability to control what websites your users can access. This data source returns every DNS filtering profile in the account, ordered by priority. DNS filtering must be enabled for this data source to work. If DNS filtering isn't enabled, the provider will throw an error.",
+		Attributes: map[string]schema.Attribute{
+			attr.ID: schema.StringAttribute{
+				Computed:    true,
+				Description: computedDatasourceIDDescription,
+			},
+			attr.DNSFilteringProfiles: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of DNS filtering profiles",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.ID: schema.StringAttribute{
+							Computed:    true,
+							Description: "The DNS filtering profile's ID.",
+						},
+						attr.Name: schema.StringAttribute{
+							Computed:    true,
+							Description: "The DNS filtering profile's name.",
+						},
+						attr.Priority: schema.Float64Attribute{
+							Computed:    true,
+							Description: "A floating point number representing the profile's priority.",
+						},
+						attr.FallbackMethod: schema.StringAttribute{
+							Computed:    true,
+							Description: "The DNS filtering profile's fallback method. One of AUTO or STRICT.",
+						},
+						attr.GroupCount: schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of groups that have this as their DNS filtering profile.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dnsFilteringProfiles) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dnsFilteringProfilesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profiles, err := d.client.ReadDNSFilteringProfiles(ctx)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, TwingateDNSFilteringProfiles)
+
+		return
+	}
+
+	data.ID = types.StringValue("dns-filtering-profiles-all")
+	data.Profiles = convertDNSFilteringProfilesToTerraform(profiles)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func convertDNSFilteringProfilesToTerraform(profiles []*model.DNSFilteringProfile) []dnsFilteringProfileItemModel {
+	sorted := slices.Clone(profiles)
+	slices.SortStableFunc(sorted, func(a, b *model.DNSFilteringProfile) int {
+		return cmp.Or(cmp.Compare(a.Priority, b.Priority), strings.Compare(a.Name, b.Name))
+	})
+
+	return utils.Map(sorted, func(profile *model.DNSFilteringProfile) dnsFilteringProfileItemModel {
+		return dnsFilteringProfileItemModel{
+			ID:             types.StringValue(profile.ID),
+			Name:           types.StringValue(profile.Name),
+			Priority:       types.Float64Value(profile.Priority),
+			FallbackMethod: types.StringValue(profile.FallbackMethod),
+			GroupCount:     types.Int64Value(int64(len(profile.Groups))),
+		}
+	})
+}
@@ -0,0 +1,45 @@
+package datasource
+
+import (
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertDNSFilteringProfilesToTerraform(t *testing.T) {
+	profiles := []*model.DNSFilteringProfile{
+		{ID: "id-3", Name: "c", Priority: 3, FallbackMethod: model.FallbackMethodStrict},
+		{ID: "id-2", Name: "b", Priority: 1.5, FallbackMethod: model.FallbackMethodAuto, Groups: []string{"g1", "g2"}},
+		{ID: "id-1", Name: "a", Priority: 1.5, FallbackMethod: model.FallbackMethodStrict, Groups: []string{"g3"}},
+	}
+
+	expected := []dnsFilteringProfileItemModel{
+		{
+			ID:             types.StringValue("id-1"),
+			Name:           types.StringValue("a"),
+			Priority:       types.Float64Value(1.5),
+			FallbackMethod: types.StringValue(model.FallbackMethodStrict),
+			GroupCount:     types.Int64Value(1),
+		},
+		{
+			ID:             types.StringValue("id-2"),
+			Name:           types.StringValue("b"),
+			Priority:       types.Float64Value(1.5),
+			FallbackMethod: types.StringValue(model.FallbackMethodAuto),
+			GroupCount:     types.Int64Value(2),
+		},
+		{
+			ID:             types.StringValue("id-3"),
+			Name:           types.StringValue("c"),
+			Priority:       types.Float64Value(3),
+			FallbackMethod: types.StringValue(model.FallbackMethodStrict),
+			GroupCount:     types.Int64Value(0),
+		},
+	}
+
+	assert.Equal(t, expected, convertDNSFilteringProfilesToTerraform(profiles))
+	assert.Equal(t, "id-3", profiles[0].ID, "input order must not change")
+	assert.Empty(t, convertDNSFilteringProfilesToTerraform(nil))
+}
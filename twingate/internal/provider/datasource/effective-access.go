@@ -0,0 +1,425 @@
+package datasource
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var ErrEffectiveAccessUserNotFound = errors.New("no user found with this email")
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSource = &effectiveAccess{}
+
+func NewEffectiveAccessDatasource() datasource.DataSource {
+	return &effectiveAccess{}
+}
+
+type effectiveAccess struct {
+	client *client.Client
+}
+
+type effectiveAccessModel struct {
+	ID                types.String                   `tfsdk:"id"`
+	UserID            types.String                   `tfsdk:"user_id"`
+	Email             types.String                   `tfsdk:"email"`
+	ServiceAccountID  types.String                   `tfsdk:"service_account_id"`
+	ResourceID        types.String                   `tfsdk:"resource_id"`
+	Resources         []effectiveAccessResourceModel `tfsdk:"resources"`
+	Users             []effectiveAccessUserModel     `tfsdk:"users"`
+	ServiceAccountIDs []types.String                 `tfsdk:"service_account_ids"`
+}
+
+type effectiveAccessResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Address          types.String `tfsdk:"address"`
+	GroupID          types.String `tfsdk:"group_id"`
+	GroupName        types.String `tfsdk:"group_name"`
+	SecurityPolicyID types.String `tfsdk:"security_policy_id"`
+}
+
+type effectiveAccessUserModel struct {
+	ID               types.String `tfsdk:"id"`
+	Email            types.String `tfsdk:"email"`
+	GroupID          types.String `tfsdk:"group_id"`
+	GroupName        types.String `tfsdk:"group_name"`
+	SecurityPolicyID types.String `tfsdk:"security_policy_id"`
+}
+
+func (d *effectiveAccess) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = TwingateEffectiveAccess
+}
+
+func (d *effectiveAccess) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+//nolint:funlen
+func (d *effectiveAccess) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	exactlyOne := []validator.String{
+		stringvalidator.ExactlyOneOf(
+			path.MatchRoot(attr.UserID),
+			path.MatchRoot(attr.Email),
+			path.MatchRoot(attr.ServiceAccountID),
+			path.MatchRoot(attr.ResourceID),
+		),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Computes the effective access of a User or Service Account: every Resource it can reach, with the Group granting access and the Security Policy that applies. " +
+			"Given a Resource instead, lists every User and Service Account that can reach it. Inactive Resources and Groups don't grant access.",
+		Attributes: map[string]schema.Attribute{
+			attr.ID: schema.StringAttribute{
+				Computed:    true,
+				Description: computedDatasourceIDDescription,
+			},
+			attr.UserID: schema.StringAttribute{
+				Optional:    true,
+				Description: "Returns the Resources the User with this ID can reach.",
+				Validators:  exactlyOne,
+			},
+			attr.Email: schema.StringAttribute{
+				Optional:    true,
+				Description: "Returns the Resources the User with this email can reach.",
+				Validators:  exactlyOne,
+			},
+			attr.ServiceAccountID: schema.StringAttribute{
+				Optional:    true,
+				Description: "Returns the Resources the Service Account with this ID can reach.",
+				Validators:  exactlyOne,
+			},
+			attr.ResourceID: schema.StringAttribute{
+				Optional:    true,
+				Description: "Returns the Users and Service Accounts that can reach the Resource with this ID.",
+				Validators:  exactlyOne,
+			},
+			attr.Resources: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The Resources the User or Service Account can reach, sorted by name. A Resource is listed once per Group granting access; Service Accounts are granted access directly.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.ID: schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Resource.",
+						},
+						attr.Name: schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the Resource.",
+						},
+						attr.Address: schema.StringAttribute{
+							Computed:    true,
+							Description: "The address of the Resource.",
+						},
+						attr.GroupID: schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Group granting access. Not set for Service Accounts.",
+						},
+						attr.GroupName: schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the Group granting access. Not set for Service Accounts.",
+						},
+						attr.SecurityPolicyID: schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Security Policy that applies: the Group's on the Resource if set, otherwise the Resource's.",
+						},
+					},
+				},
+			},
+			attr.Users: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The Users that can reach the Resource, sorted by email. A User is listed once per Group granting access.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.ID: schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the User.",
+						},
+						attr.Email: schema.StringAttribute{
+							Computed:    true,
+							Description: "The email of the User.",
+						},
+						attr.GroupID: schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Group granting access.",
+						},
+						attr.GroupName: schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the Group granting access.",
+						},
+						attr.SecurityPolicyID: schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Security Policy that applies: the Group's on the Resource if set, otherwise the Resource's.",
+						},
+					},
+				},
+			},
+			attr.ServiceAccountIDs: schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the Service Accounts that can reach the Resource.",
+			},
+		},
+	}
+}
+
+func (d *effectiveAccess) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data effectiveAccessModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+
+	if data.ResourceID.IsNull() {
+		err = d.readPrincipalAccess(ctx, &data)
+	} else {
+		err = d.readResourceAccess(ctx, &data)
+	}
+
+	if err != nil {
+		addErr(&resp.Diagnostics, err, TwingateEffectiveAccess)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readPrincipalAccess lists the Resources a User or Service Account can reach.
+func (d *effectiveAccess) readPrincipalAccess(ctx context.Context, data *effectiveAccessModel) error {
+	resources, err := d.client.ReadFullResources(ctx)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	data.Users = []effectiveAccessUserModel{}
+	data.ServiceAccountIDs = []types.String{}
+
+	if !data.ServiceAccountID.IsNull() {
+		data.ID = types.StringValue("effective-access-service-account-" + data.ServiceAccountID.ValueString())
+		data.Resources = serviceAccountEffectiveAccess(data.ServiceAccountID.ValueString(), resources)
+
+		return nil
+	}
+
+	userID := data.UserID.ValueString()
+
+	if !data.Email.IsNull() {
+		users, err := d.client.ReadUsers(ctx, &client.UsersFilter{Email: &client.StringFilter{Name: data.Email.ValueString()}})
+		if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+			return err //nolint:wrapcheck
+		}
+
+		if len(users) == 0 {
+			return fmt.Errorf("%w: %s", ErrEffectiveAccessUserNotFound, data.Email.ValueString())
+		}
+
+		userID = users[0].ID
+		data.UserID = types.StringValue(userID)
+	}
+
+	groups, err := d.client.ReadFullGroups(ctx)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	data.ID = types.StringValue("effective-access-user-" + userID)
+	data.Resources = userEffectiveAccess(userID, resources, groups)
+
+	return nil
+}
+
+// readResourceAccess lists the Users and Service Accounts that can reach a Resource.
+func (d *effectiveAccess) readResourceAccess(ctx context.Context, data *effectiveAccessModel) error {
+	resource, err := d.client.ReadResource(ctx, data.ResourceID.ValueString())
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	groups, err := d.client.ReadFullGroups(ctx)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	users, err := d.client.ReadUsers(ctx, nil)
+	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		return err //nolint:wrapcheck
+	}
+
+	data.ID = types.StringValue("effective-access-resource-" + resource.ID)
+	data.Resources = []effectiveAccessResourceModel{}
+	data.Users = resourceEffectiveAccess(resource, groups, users)
+	data.ServiceAccountIDs = []types.String{}
+
+	if resource.IsActive {
+		for _, id := range resource.ServiceAccounts {
+			data.ServiceAccountIDs = append(data.ServiceAccountIDs, types.StringValue(id))
+		}
+	}
+
+	return nil
+}
+
+func userEffectiveAccess(userID string, resources []*model.Resource, groups []*model.Group) []effectiveAccessResourceModel {
+	memberOf := make(map[string]*model.Group)
+
+	for _, group := range groups {
+		if group.IsActive && slices.Contains(group.Users, userID) {
+			memberOf[group.ID] = group
+		}
+	}
+
+	access := []effectiveAccessResourceModel{}
+
+	for _, resource := range resources {
+		if !resource.IsActive {
+			continue
+		}
+
+		for _, groupAccess := range resource.GroupsAccess {
+			group, ok := memberOf[groupAccess.GroupID]
+			if !ok {
+				continue
+			}
+
+			access = append(access, effectiveAccessResourceModel{
+				ID:               types.StringValue(resource.ID),
+				Name:             types.StringValue(resource.Name),
+				Address:          types.StringValue(resource.Address),
+				GroupID:          types.StringValue(group.ID),
+				GroupName:        types.StringValue(group.Name),
+				SecurityPolicyID: effectiveSecurityPolicy(resource, groupAccess),
+			})
+		}
+	}
+
+	slices.SortStableFunc(access, func(a, b effectiveAccessResourceModel) int {
+		return cmp.Or(
+			cmp.Compare(a.Name.ValueString(), b.Name.ValueString()),
+			cmp.Compare(a.ID.ValueString(), b.ID.ValueString()),
+			cmp.Compare(a.GroupName.ValueString(), b.GroupName.ValueString()),
+		)
+	})
+
+	return access
+}
+
+func serviceAccountEffectiveAccess(serviceAccountID string, resources []*model.Resource) []effectiveAccessResourceModel {
+	access := []effectiveAccessResourceModel{}
+
+	for _, resource := range resources {
+		if !resource.IsActive || !slices.Contains(resource.ServiceAccounts, serviceAccountID) {
+			continue
+		}
+
+		access = append(access, effectiveAccessResourceModel{
+			ID:               types.StringValue(resource.ID),
+			Name:             types.StringValue(resource.Name),
+			Address:          types.StringValue(resource.Address),
+			GroupID:          types.StringNull(),
+			GroupName:        types.StringNull(),
+			SecurityPolicyID: types.StringPointerValue(resource.SecurityPolicyID),
+		})
+	}
+
+	slices.SortStableFunc(access, func(a, b effectiveAccessResourceModel) int {
+		return cmp.Or(
+			cmp.Compare(a.Name.ValueString(), b.Name.ValueString()),
+			cmp.Compare(a.ID.ValueString(), b.ID.ValueString()),
+		)
+	})
+
+	return access
+}
+
+func resourceEffectiveAccess(resource *model.Resource, groups []*model.Group, users []*model.User) []effectiveAccessUserModel {
+	access := []effectiveAccessUserModel{}
+
+	if !resource.IsActive {
+		return access
+	}
+
+	groupsByID := make(map[string]*model.Group, len(groups))
+	for _, group := range groups {
+		groupsByID[group.ID] = group
+	}
+
+	emails := make(map[string]string, len(users))
+	for _, user := range users {
+		emails[user.ID] = user.Email
+	}
+
+	for _, groupAccess := range resource.GroupsAccess {
+		group, ok := groupsByID[groupAccess.GroupID]
+		if !ok || !group.IsActive {
+			continue
+		}
+
+		for _, userID := range group.Users {
+			email := types.StringNull()
+			if value, ok := emails[userID]; ok {
+				email = types.StringValue(value)
+			}
+
+			access = append(access, effectiveAccessUserModel{
+				ID:               types.StringValue(userID),
+				Email:            email,
+				GroupID:          types.StringValue(group.ID),
+				GroupName:        types.StringValue(group.Name),
+				SecurityPolicyID: effectiveSecurityPolicy(resource, groupAccess),
+			})
+		}
+	}
+
+	slices.SortStableFunc(access, func(a, b effectiveAccessUserModel) int {
+		return cmp.Or(
+			cmp.Compare(a.Email.ValueString(), b.Email.ValueString()),
+			cmp.Compare(a.ID.ValueString(), b.ID.ValueString()),
+			cmp.Compare(a.GroupName.ValueString(), b.GroupName.ValueString()),
+		)
+	})
+
+	return access
+}
+
+// effectiveSecurityPolicy returns the Security Policy of the Group's access to the Resource, falling back to the Resource's.
+func effectiveSecurityPolicy(resource *model.Resource, groupAccess model.AccessGroup) types.String {
+	if groupAccess.SecurityPolicyID != nil && *groupAccess.SecurityPolicyID != "" {
+		return types.StringValue(*groupAccess.SecurityPolicyID)
+	}
+
+	return types.StringPointerValue(resource.SecurityPolicyID)
+}
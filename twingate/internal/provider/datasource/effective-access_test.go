@@ -0,0 +1,101 @@
+package datasource
+
+import (
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func effectiveAccessFixtures() ([]*model.Resource, []*model.Group, []*model.User) {
+	resourcePolicy := "policy-resource"
+	groupPolicy := "policy-group"
+
+	resources := []*model.Resource{
+		{
+			ID: "res-db", Name: "db", Address: "db.internal", IsActive: true, SecurityPolicyID: &resourcePolicy,
+			GroupsAccess:    []model.AccessGroup{{GroupID: "grp-eng"}, {GroupID: "grp-ops", SecurityPolicyID: &groupPolicy}},
+			ServiceAccounts: []string{"sa-ci"},
+		},
+		{
+			ID: "res-app", Name: "app", Address: "app.internal", IsActive: true,
+			GroupsAccess: []model.AccessGroup{{GroupID: "grp-eng"}, {GroupID: "grp-inactive"}},
+		},
+		{
+			ID: "res-old", Name: "old", Address: "old.internal", IsActive: false,
+			GroupsAccess:    []model.AccessGroup{{GroupID: "grp-eng"}},
+			ServiceAccounts: []string{"sa-ci"},
+		},
+	}
+
+	groups := []*model.Group{
+		{ID: "grp-eng", Name: "Engineering", IsActive: true, Users: []string{"usr-alice", "usr-bob"}},
+		{ID: "grp-ops", Name: "Ops", IsActive: true, Users: []string{"usr-alice"}},
+		{ID: "grp-inactive", Name: "Inactive", IsActive: false, Users: []string{"usr-carol"}},
+	}
+
+	users := []*model.User{
+		{ID: "usr-alice", Email: "alice@example.com"},
+		{ID: "usr-bob", Email: "bob@example.com"},
+	}
+
+	return resources, groups, users
+}
+
+func TestUserEffectiveAccess(t *testing.T) {
+	resources, groups, _ := effectiveAccessFixtures()
+
+	assert.Equal(t, []effectiveAccessResourceModel{
+		{
+			ID: types.StringValue("res-app"), Name: types.StringValue("app"), Address: types.StringValue("app.internal"),
+			GroupID: types.StringValue("grp-eng"), GroupName: types.StringValue("Engineering"), SecurityPolicyID: types.StringNull(),
+		},
+		{
+			ID: types.StringValue("res-db"), Name: types.StringValue("db"), Address: types.StringValue("db.internal"),
+			GroupID: types.StringValue("grp-eng"), GroupName: types.StringValue("Engineering"), SecurityPolicyID: types.StringValue("policy-resource"),
+		},
+		{
+			ID: types.StringValue("res-db"), Name: types.StringValue("db"), Address: types.StringValue("db.internal"),
+			GroupID: types.StringValue("grp-ops"), GroupName: types.StringValue("Ops"), SecurityPolicyID: types.StringValue("policy-group"),
+		},
+	}, userEffectiveAccess("usr-alice", resources, groups))
+
+	// only member of an inactive group
+	assert.Equal(t, []effectiveAccessResourceModel{}, userEffectiveAccess("usr-carol", resources, groups))
+}
+
+func TestServiceAccountEffectiveAccess(t *testing.T) {
+	resources, _, _ := effectiveAccessFixtures()
+
+	assert.Equal(t, []effectiveAccessResourceModel{
+		{
+			ID: types.StringValue("res-db"), Name: types.StringValue("db"), Address: types.StringValue("db.internal"),
+			GroupID: types.StringNull(), GroupName: types.StringNull(), SecurityPolicyID: types.StringValue("policy-resource"),
+		},
+	}, serviceAccountEffectiveAccess("sa-ci", resources))
+
+	assert.Equal(t, []effectiveAccessResourceModel{}, serviceAccountEffectiveAccess("sa-unknown", resources))
+}
+
+func TestResourceEffectiveAccess(t *testing.T) {
+	resources, groups, users := effectiveAccessFixtures()
+
+	assert.Equal(t, []effectiveAccessUserModel{
+		{
+			ID: types.StringValue("usr-alice"), Email: types.StringValue("alice@example.com"),
+			GroupID: types.StringValue("grp-eng"), GroupName: types.StringValue("Engineering"), SecurityPolicyID: types.StringValue("policy-resource"),
+		},
+		{
+			ID: types.StringValue("usr-alice"), Email: types.StringValue("alice@example.com"),
+			GroupID: types.StringValue("grp-ops"), GroupName: types.StringValue("Ops"), SecurityPolicyID: types.StringValue("policy-group"),
+		},
+		{
+			ID: types.StringValue("usr-bob"), Email: types.StringValue("bob@example.com"),
+			GroupID: types.StringValue("grp-eng"), GroupName: types.StringValue("Engineering"), SecurityPolicyID: types.StringValue("policy-resource"),
+		},
+	}, resourceEffectiveAccess(resources[0], groups, users))
+
+	// inactive resources grant no access
+	assert.Equal(t, []effectiveAccessUserModel{}, resourceEffectiveAccess(resources[2], groups, users))
+}
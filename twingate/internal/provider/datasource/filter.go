@@ -0,0 +1,154 @@
+package datasource
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var ErrInvalidFilter = errors.New("invalid filter")
+
+type filterModel struct {
+	Match      types.String           `tfsdk:"match"`
+	Conditions []filterConditionModel `tfsdk:"condition"`
+}
+
+type filterConditionModel struct {
+	Field    types.String `tfsdk:"field"`
+	Key      types.String `tfsdk:"key"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+	Negate   types.Bool   `tfsdk:"negate"`
+}
+
+// filterBlock returns the schema of the `filter` block shared by the plural data sources,
+// accepting conditions on the given fields.
+func filterBlock(fields ...string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "Narrows down the results with a set of conditions. Multiple `filter` blocks must all match. Can be combined with the other filter arguments.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				attr.Match: schema.StringAttribute{
+					Optional:    true,
+					Description: fmt.Sprintf("Whether `%s` (default) or `%s` of the conditions must match.", model.FilterMatchAll, model.FilterMatchAny),
+					Validators: []validator.String{
+						stringvalidator.OneOf(model.FilterMatches...),
+					},
+				},
+			},
+			Blocks: map[string]schema.Block{
+				attr.Condition: schema.ListNestedBlock{
+					Description: "A condition on a single field.",
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtLeast(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							attr.Field: schema.StringAttribute{
+								Required:    true,
+								Description: fmt.Sprintf("The field to test. One of: `%s`.", strings.Join(fields, "`, `")),
+								Validators: []validator.String{
+									stringvalidator.OneOf(fields...),
+								},
+							},
+							attr.Key: schema.StringAttribute{
+								Optional:    true,
+								Description: fmt.Sprintf("The tag key to test. Required when `%s` is `%s`.", attr.Field, attr.Tag),
+							},
+							attr.Operator: schema.StringAttribute{
+								Optional:    true,
+								Description: fmt.Sprintf("How to compare the field with `%s`. One of: `%s`. Defaults to `%s`.", attr.Value, strings.Join(model.FilterOperators, "`, `"), model.FilterOperatorEquals),
+								Validators: []validator.String{
+									stringvalidator.OneOf(model.FilterOperators...),
+								},
+							},
+							attr.Value: schema.StringAttribute{
+								Required:    true,
+								Description: "The value to compare the field with.",
+							},
+							attr.Negate: schema.BoolAttribute{
+								Optional:    true,
+								Description: "Inverts the condition. Defaults to `false`.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildFilterExpression(filters []filterModel) (model.FilterExpression, error) {
+	expression := make(model.FilterExpression, 0, len(filters))
+
+	for _, filter := range filters {
+		group := model.FilterGroup{
+			MatchAny:   filter.Match.ValueString() == model.FilterMatchAny,
+			Conditions: make([]model.FilterCondition, 0, len(filter.Conditions)),
+		}
+
+		for _, condition := range filter.Conditions {
+			cond := model.FilterCondition{
+				Field:    condition.Field.ValueString(),
+				Key:      condition.Key.ValueString(),
+				Operator: condition.Operator.ValueString(),
+				Value:    condition.Value.ValueString(),
+				Negate:   condition.Negate.ValueBool(),
+			}
+
+			if err := validateFilterCondition(&cond); err != nil {
+				return nil, err
+			}
+
+			group.Conditions = append(group.Conditions, cond)
+		}
+
+		expression = append(expression, group)
+	}
+
+	return expression, nil
+}
+
+// validateFilterCondition checks the condition and compiles the pattern of regexp conditions.
+func validateFilterCondition(condition *model.FilterCondition) error {
+	if condition.Field == attr.Tag && condition.Key == "" {
+		return fmt.Errorf("%w: %q is required for %q conditions", ErrInvalidFilter, attr.Key, attr.Tag)
+	}
+
+	if condition.Field != attr.Tag && condition.Key != "" {
+		return fmt.Errorf("%w: %q can only be set for %q conditions", ErrInvalidFilter, attr.Key, attr.Tag)
+	}
+
+	if condition.Operator == model.FilterOperatorRegexp {
+		pattern, err := regexp.Compile(condition.Value)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidFilter, err)
+		}
+
+		condition.Pattern = pattern
+	}
+
+	return nil
+}
+
+// applyFilter keeps the items matching the expression.
+func applyFilter[T model.Filterable](items []T, expression model.FilterExpression) []T {
+	if len(expression) == 0 {
+		return items
+	}
+
+	return utils.Filter(items, func(item T) bool {
+		return expression.Match(item)
+	})
+}
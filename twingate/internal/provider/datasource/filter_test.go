@@ -0,0 +1,151 @@
+package datasource
+
+import (
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func condition(field, operator, value string, negate bool) filterConditionModel {
+	return filterConditionModel{
+		Field:    types.StringValue(field),
+		Key:      types.StringNull(),
+		Operator: types.StringValue(operator),
+		Value:    types.StringValue(value),
+		Negate:   types.BoolValue(negate),
+	}
+}
+
+func tagCondition(key, value string) filterConditionModel {
+	cond := condition(attr.Tag, model.FilterOperatorEquals, value, false)
+	cond.Key = types.StringValue(key)
+
+	return cond
+}
+
+func TestFilterExpression(t *testing.T) {
+	resources := []*model.Resource{
+		{ID: "1", Name: "prod-db", Address: "db.prod.internal", Tags: map[string]string{"env": "prod"}},
+		{ID: "2", Name: "prod-legacy-db", Address: "legacy.prod.internal", Tags: map[string]string{"env": "prod"}},
+		{ID: "3", Name: "staging-db", Address: "db.staging.internal"},
+		{ID: "4", Name: "prod-cache", Address: "10.0.0.4"},
+	}
+
+	cases := []struct {
+		name             string
+		filters          []filterModel
+		expected         []string
+		expectedPushDown string
+	}{
+		{
+			name:     "no filters",
+			expected: []string{"1", "2", "3", "4"},
+		},
+		{
+			name: "prefix and not containing",
+			filters: []filterModel{{Conditions: []filterConditionModel{
+				condition(attr.Name, model.FilterOperatorPrefix, "prod-", false),
+				condition(attr.Name, model.FilterOperatorContains, "legacy", true),
+			}}},
+			expected:         []string{"1", "4"},
+			expectedPushDown: "prod-",
+		},
+		{
+			name: "match any",
+			filters: []filterModel{{Match: types.StringValue(model.FilterMatchAny), Conditions: []filterConditionModel{
+				condition(attr.Name, model.FilterOperatorSuffix, "-cache", false),
+				condition(attr.Address, model.FilterOperatorRegexp, `^db\.`, false),
+			}}},
+			expected: []string{"1", "3", "4"},
+		},
+		{
+			name: "filter blocks are combined with AND",
+			filters: []filterModel{
+				{Match: types.StringValue(model.FilterMatchAny), Conditions: []filterConditionModel{
+					condition(attr.Name, model.FilterOperatorContains, "db", false),
+				}},
+				{Conditions: []filterConditionModel{tagCondition("env", "prod")}},
+			},
+			expected:         []string{"1", "2"},
+			expectedPushDown: "db",
+		},
+		{
+			name: "negated condition on a missing tag",
+			filters: []filterModel{{Conditions: []filterConditionModel{
+				func() filterConditionModel {
+					cond := tagCondition("env", "prod")
+					cond.Negate = types.BoolValue(true)
+
+					return cond
+				}(),
+			}}},
+			expected: []string{"3", "4"},
+		},
+		{
+			name: "default operator is equals",
+			filters: []filterModel{{Conditions: []filterConditionModel{
+				condition(attr.Address, "", "10.0.0.4", false),
+			}}},
+			expected: []string{"4"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			expression, err := buildFilterExpression(c.filters)
+			assert.NoError(t, err)
+
+			ids := make([]string, 0)
+			for _, res := range applyFilter(resources, expression) {
+				ids = append(ids, res.ID)
+			}
+
+			assert.Equal(t, c.expected, ids)
+
+			pushDown, _ := expression.PushDown(attr.Name)
+			assert.Equal(t, c.expectedPushDown, pushDown)
+		})
+	}
+}
+
+func TestFilterExpressionPushDown(t *testing.T) {
+	expression := model.FilterExpression{
+		{MatchAny: true, Conditions: []model.FilterCondition{
+			{Field: attr.Name, Operator: model.FilterOperatorPrefix, Value: "a"},
+			{Field: attr.Name, Operator: model.FilterOperatorPrefix, Value: "b"},
+		}},
+		{Conditions: []model.FilterCondition{
+			{Field: attr.Name, Operator: model.FilterOperatorContains, Value: "x", Negate: true},
+			{Field: attr.Name, Operator: model.FilterOperatorRegexp, Value: "^c"},
+		}},
+	}
+
+	value, filterBy := expression.PushDown(attr.Name)
+	assert.Equal(t, "^c", value)
+	assert.Equal(t, attr.FilterByRegexp, filterBy)
+
+	value, filterBy = expression.PushDown(attr.Address)
+	assert.Empty(t, value)
+	assert.Empty(t, filterBy)
+}
+
+func TestBuildFilterExpressionErrors(t *testing.T) {
+	cases := []filterConditionModel{
+		condition(attr.Tag, model.FilterOperatorEquals, "prod", false),
+		func() filterConditionModel {
+			cond := condition(attr.Name, model.FilterOperatorEquals, "prod", false)
+			cond.Key = types.StringValue("env")
+
+			return cond
+		}(),
+		condition(attr.Name, model.FilterOperatorRegexp, "([a-z", false),
+	}
+
+	for _, cond := range cases {
+		_, err := buildFilterExpression([]filterModel{{Conditions: []filterConditionModel{cond}}})
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+	}
+}
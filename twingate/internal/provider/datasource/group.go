@@ -3,10 +3,13 @@ package datasource
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -30,6 +33,16 @@ type groupModel struct {
 	IsActive types.Bool   `tfsdk:"is_active"`
 }
 
+type groupLookupModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
+	IsActive      types.Bool   `tfsdk:"is_active"`
+	ReverseLookup types.Bool   `tfsdk:"reverse_lookup"`
+	UserIDs       types.Set    `tfsdk:"user_ids"`
+	ResourceIDs   types.Set    `tfsdk:"resource_ids"`
+}
+
 func (d *group) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = TwingateGroup
 }
@@ -60,11 +73,25 @@ func (d *group) Schema(ctx context.Context, req datasource.SchemaRequest, resp *
 				Required:    true,
 				Description: "The ID of the Group. The ID for the Group can be obtained from the Admin API or the URL string in the Admin Console.",
 			},
+			attr.ReverseLookup: schema.BoolAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Set to `true` to populate `%s` and `%s`. This reads every Resource in the account, so it's off by default.", attr.UserIDs, attr.ResourceIDs),
+			},
 			// computed
 			attr.Name: schema.StringAttribute{
 				Computed:    true,
 				Description: "The name of the Group",
 			},
+			attr.UserIDs: schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: fmt.Sprintf("The IDs of the Group's members. Only set when `%s` is `true`.", attr.ReverseLookup),
+			},
+			attr.ResourceIDs: schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: fmt.Sprintf("The IDs of the Resources the Group has been granted access to. Only set when `%s` is `true`.", attr.ReverseLookup),
+			},
 			attr.IsActive: schema.BoolAttribute{
 				Computed:    true,
 				Description: "Indicates if the Group is active",
@@ -78,7 +105,7 @@ func (d *group) Schema(ctx context.Context, req datasource.SchemaRequest, resp *
 }
 
 func (d *group) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data groupModel
+	var data groupLookupModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -97,7 +124,34 @@ func (d *group) Read(ctx context.Context, req datasource.ReadRequest, resp *data
 	data.Name = types.StringValue(group.Name)
 	data.Type = types.StringValue(group.Type)
 	data.IsActive = types.BoolValue(group.IsActive)
+	data.UserIDs = types.SetNull(types.StringType)
+	data.ResourceIDs = types.SetNull(types.StringType)
+
+	if data.ReverseLookup.ValueBool() {
+		resources, err := d.client.ReadFullResources(client.WithCallerCtx(ctx, datasourceKey))
+		if err != nil {
+			addErr(&resp.Diagnostics, err, TwingateGroup)
+
+			return
+		}
+
+		data.UserIDs = utils.MakeStringSet(group.Users)
+		data.ResourceIDs = utils.MakeStringSet(resourcesGrantedToGroup(resources, group.ID))
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// resourcesGrantedToGroup returns the IDs of the Resources that have an access grant for the Group.
+func resourcesGrantedToGroup(resources []*model.Resource, groupID string) []string {
+	return utils.FilterMap(resources,
+		func(resource *model.Resource) bool {
+			return slices.ContainsFunc(resource.GroupsAccess, func(access model.AccessGroup) bool {
+				return access.GroupID == groupID
+			})
+		},
+		func(resource *model.Resource) string {
+			return resource.ID
+		})
+}
@@ -33,16 +33,17 @@ type groups struct {
 }
 
 type groupsModel struct {
-	ID           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	NameRegexp   types.String `tfsdk:"name_regexp"`
-	NameContains types.String `tfsdk:"name_contains"`
-	NameExclude  types.String `tfsdk:"name_exclude"`
-	NamePrefix   types.String `tfsdk:"name_prefix"`
-	NameSuffix   types.String `tfsdk:"name_suffix"`
-	Types        types.Set    `tfsdk:"types"`
-	IsActive     types.Bool   `tfsdk:"is_active"`
-	Groups       []groupModel `tfsdk:"groups"`
+	ID           types.String  `tfsdk:"id"`
+	Name         types.String  `tfsdk:"name"`
+	NameRegexp   types.String  `tfsdk:"name_regexp"`
+	NameContains types.String  `tfsdk:"name_contains"`
+	NameExclude  types.String  `tfsdk:"name_exclude"`
+	NamePrefix   types.String  `tfsdk:"name_prefix"`
+	NameSuffix   types.String  `tfsdk:"name_suffix"`
+	Types        types.Set     `tfsdk:"types"`
+	IsActive     types.Bool    `tfsdk:"is_active"`
+	Groups       []groupModel  `tfsdk:"groups"`
+	Filter       []filterModel `tfsdk:"filter"`
 }
 
 func (d *groups) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -138,6 +139,9 @@ func (d *groups) Schema(ctx context.Context, req datasource.SchemaRequest, resp
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			attr.Filter: filterBlock(attr.Name, attr.Type),
+		},
 	}
 }
 
@@ -157,8 +161,24 @@ func (d *groups) Read(ctx context.Context, req datasource.ReadRequest, resp *dat
 		return
 	}
 
+	expression, err := buildFilterExpression(data.Filter)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, TwingateGroups)
+
+		return
+	}
+
 	filter := buildFilter(&data)
 
+	if name, nameFilter := expression.PushDown(attr.Name); name != "" && !filter.HasName() {
+		if filter == nil {
+			filter = &model.GroupsFilter{}
+		}
+
+		filter.Name = &name
+		filter.NameFilter = nameFilter
+	}
+
 	groups, err := d.client.ReadGroups(client.WithCallerCtx(ctx, datasourceKey), filter)
 	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
 		addErr(&resp.Diagnostics, err, TwingateGroups)
@@ -166,7 +186,7 @@ func (d *groups) Read(ctx context.Context, req datasource.ReadRequest, resp *dat
 		return
 	}
 
-	data.Groups = convertGroupsToTerraform(groups)
+	data.Groups = convertGroupsToTerraform(applyFilter(groups, expression))
 
 	id := "all-groups"
 	if filter.HasName() {
@@ -2,6 +2,7 @@ package datasource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -35,6 +37,17 @@ type remoteNetworkModel struct {
 	Type     types.String `tfsdk:"type"`
 }
 
+type remoteNetworkLookupModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Location      types.String `tfsdk:"location"`
+	Type          types.String `tfsdk:"type"`
+	ReverseLookup types.Bool   `tfsdk:"reverse_lookup"`
+	ConnectorIDs  types.Set    `tfsdk:"connector_ids"`
+	ResourceIDs   types.Set    `tfsdk:"resource_ids"`
+	GatewayIDs    types.Set    `tfsdk:"gateway_ids"`
+}
+
 func (d *remoteNetwork) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = TwingateRemoteNetwork
 }
@@ -79,6 +92,10 @@ func (d *remoteNetwork) Schema(ctx context.Context, req datasource.SchemaRequest
 					}...),
 				},
 			},
+			attr.ReverseLookup: schema.BoolAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Set to `true` to populate `%s`, `%s` and `%s`. This reads every Connector and Gateway in the account, so it's off by default.", attr.ConnectorIDs, attr.ResourceIDs, attr.GatewayIDs),
+			},
 			attr.Location: schema.StringAttribute{
 				Computed:    true,
 				Description: fmt.Sprintf("The location of the Remote Network. Must be one of the following: %s.", strings.Join(model.Locations, ", ")),
@@ -87,12 +104,27 @@ func (d *remoteNetwork) Schema(ctx context.Context, req datasource.SchemaRequest
 				Computed:    true,
 				Description: fmt.Sprintf("The type of the Remote Network. Must be one of the following: %s.", strings.Join([]string{model.NetworkTypeRegular, model.NetworkTypeExit}, ", ")),
 			},
+			attr.ConnectorIDs: schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: fmt.Sprintf("The IDs of the Connectors deployed in the Remote Network. Only set when `%s` is `true`.", attr.ReverseLookup),
+			},
+			attr.ResourceIDs: schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: fmt.Sprintf("The IDs of the Resources that belong to the Remote Network. Only set when `%s` is `true`.", attr.ReverseLookup),
+			},
+			attr.GatewayIDs: schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: fmt.Sprintf("The IDs of the Gateways in the Remote Network. Only set when `%s` is `true`.", attr.ReverseLookup),
+			},
 		},
 	}
 }
 
 func (d *remoteNetwork) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data remoteNetworkModel
+	var data remoteNetworkLookupModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -112,7 +144,66 @@ func (d *remoteNetwork) Read(ctx context.Context, req datasource.ReadRequest, re
 	data.Name = types.StringValue(network.Name)
 	data.Location = types.StringValue(network.Location)
 	data.Type = types.StringValue(network.Type)
+	data.ConnectorIDs = types.SetNull(types.StringType)
+	data.ResourceIDs = types.SetNull(types.StringType)
+	data.GatewayIDs = types.SetNull(types.StringType)
+
+	if data.ReverseLookup.ValueBool() {
+		if err := d.reverseLookup(ctx, &data); err != nil {
+			addErr(&resp.Diagnostics, err, TwingateRemoteNetwork)
+
+			return
+		}
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// reverseLookup fills in the IDs of the Connectors, Resources and Gateways attached to the Remote Network.
+func (d *remoteNetwork) reverseLookup(ctx context.Context, data *remoteNetworkLookupModel) error {
+	networkID := data.ID.ValueString()
+
+	connectors, err := d.client.ReadConnectors(ctx, "", "")
+	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		return err //nolint:wrapcheck
+	}
+
+	resources, err := d.client.ReadFullResourcesByName(client.WithCallerCtx(ctx, datasourceKey), &model.ResourcesFilter{RemoteNetworkID: &networkID})
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	gateways, err := d.client.ReadGateways(ctx)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	data.ConnectorIDs = utils.MakeStringSet(connectorsInNetwork(connectors, networkID))
+	data.ResourceIDs = utils.MakeStringSet(utils.Map(resources, func(resource *model.Resource) string {
+		return resource.ID
+	}))
+	data.GatewayIDs = utils.MakeStringSet(gatewaysInNetwork(gateways, networkID))
+
+	return nil
+}
+
+func connectorsInNetwork(connectors []*model.Connector, networkID string) []string {
+	return utils.FilterMap(connectors,
+		func(connector *model.Connector) bool {
+			return connector.NetworkID == networkID
+		},
+		func(connector *model.Connector) string {
+			return connector.ID
+		})
+}
+
+func gatewaysInNetwork(gateways []*model.Gateway, networkID string) []string {
+	return utils.FilterMap(gateways,
+		func(gateway *model.Gateway) bool {
+			return gateway.RemoteNetworkID == networkID
+		},
+		func(gateway *model.Gateway) string {
+			return gateway.ID
+		})
+}
@@ -37,6 +37,7 @@ type remoteNetworksModel struct {
 	NamePrefix     types.String         `tfsdk:"name_prefix"`
 	NameSuffix     types.String         `tfsdk:"name_suffix"`
 	RemoteNetworks []remoteNetworkModel `tfsdk:"remote_networks"`
+	Filter         []filterModel        `tfsdk:"filter"`
 }
 
 func (d *remoteNetworks) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -120,6 +121,9 @@ func (d *remoteNetworks) Schema(ctx context.Context, req datasource.SchemaReques
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			attr.Filter: filterBlock(attr.Name, attr.Location, attr.Type),
+		},
 	}
 }
 
@@ -141,6 +145,17 @@ func (d *remoteNetworks) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
+	expression, err := buildFilterExpression(data.Filter)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, TwingateRemoteNetworks)
+
+		return
+	}
+
+	if name == "" {
+		name, filter = expression.PushDown(attr.Name)
+	}
+
 	networks, err := d.client.ReadRemoteNetworks(ctx, name, filter)
 	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
 		addErr(&resp.Diagnostics, err, TwingateRemoteNetworks)
@@ -149,7 +164,7 @@ func (d *remoteNetworks) Read(ctx context.Context, req datasource.ReadRequest, r
 	}
 
 	data.ID = types.StringValue("all-remote-networks")
-	data.RemoteNetworks = convertRemoteNetworksToTerraform(networks)
+	data.RemoteNetworks = convertRemoteNetworksToTerraform(applyFilter(networks, expression))
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
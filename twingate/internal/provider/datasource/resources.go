@@ -4,17 +4,35 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var ErrResourcesDatasourceShouldSetOneOptionalNameAttribute = errors.New("Only one of name, name_regex, name_contains, name_exclude, name_prefix or name_suffix must be set.")
+var (
+	ErrResourcesDatasourceShouldSetOneOptionalNameAttribute = errors.New("Only one of name, name_regex, name_contains, name_exclude, name_prefix or name_suffix must be set.")
+	ErrInvalidAddressFilter                                 = errors.New("invalid address filter")
+)
+
+const (
+	addressModeExact        = "exact"
+	addressModeCIDRContains = "cidr_contains"
+	addressModeCIDROverlaps = "cidr_overlaps"
+	addressModeWildcard     = "wildcard"
+)
+
+//nolint:gochecknoglobals
+var addressModes = []string{addressModeExact, addressModeCIDRContains, addressModeCIDROverlaps, addressModeWildcard}
 
 // Ensure the implementation satisfies the desired interfaces.
 var _ datasource.DataSource = &resources{}
@@ -28,15 +46,21 @@ type resources struct {
 }
 
 type resourcesModel struct {
-	ID           types.String    `tfsdk:"id"`
-	Name         types.String    `tfsdk:"name"`
-	NameRegexp   types.String    `tfsdk:"name_regexp"`
-	NameContains types.String    `tfsdk:"name_contains"`
-	NameExclude  types.String    `tfsdk:"name_exclude"`
-	NamePrefix   types.String    `tfsdk:"name_prefix"`
-	NameSuffix   types.String    `tfsdk:"name_suffix"`
-	Tags         types.Map       `tfsdk:"tags"`
-	Resources    []resourceModel `tfsdk:"resources"`
+	ID               types.String    `tfsdk:"id"`
+	Name             types.String    `tfsdk:"name"`
+	NameRegexp       types.String    `tfsdk:"name_regexp"`
+	NameContains     types.String    `tfsdk:"name_contains"`
+	NameExclude      types.String    `tfsdk:"name_exclude"`
+	NamePrefix       types.String    `tfsdk:"name_prefix"`
+	NameSuffix       types.String    `tfsdk:"name_suffix"`
+	Tags             types.Map       `tfsdk:"tags"`
+	Address          types.String    `tfsdk:"address"`
+	AddressMode      types.String    `tfsdk:"address_mode"`
+	GroupID          types.String    `tfsdk:"group_id"`
+	SecurityPolicyID types.String    `tfsdk:"security_policy_id"`
+	IsActive         types.Bool      `tfsdk:"is_active"`
+	Resources        []resourceModel `tfsdk:"resources"`
+	Filter           []filterModel   `tfsdk:"filter"`
 }
 
 func (d *resources) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -116,6 +140,33 @@ func (d *resources) Schema(ctx context.Context, req datasource.SchemaRequest, re
 				Optional:    true,
 				Description: "Returns only resources that exactly match the given tags.",
 			},
+			attr.Address: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Returns only Resources whose address matches this value, compared as set by `%s`.", attr.AddressMode),
+			},
+			attr.AddressMode: schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("How `%s` is compared with the Resources' addresses. `%s` (default) matches the address as is, "+
+					"`%s` matches IP and CIDR addresses within the given range, `%s` matches IP and CIDR addresses sharing any part of the given range "+
+					"and `%s` matches addresses against a DNS wildcard such as `*.example.com`, or wildcard addresses covering the given host name.",
+					attr.Address, addressModeExact, addressModeCIDRContains, addressModeCIDROverlaps, addressModeWildcard),
+				Validators: []validator.String{
+					stringvalidator.OneOf(addressModes...),
+					stringvalidator.AlsoRequires(path.MatchRoot(attr.Address)),
+				},
+			},
+			attr.GroupID: schema.StringAttribute{
+				Optional:    true,
+				Description: "Returns only Resources the Group has been granted access to.",
+			},
+			attr.SecurityPolicyID: schema.StringAttribute{
+				Optional:    true,
+				Description: "Returns only Resources using this Security Policy, either as their default policy or on one of their group access grants.",
+			},
+			attr.IsActive: schema.BoolAttribute{
+				Optional:    true,
+				Description: "Returns only Resources matching the specified state.",
+			},
 			// computed
 			attr.Resources: schema.ListNestedAttribute{
 				Computed:    true,
@@ -163,6 +214,9 @@ func (d *resources) Schema(ctx context.Context, req datasource.SchemaRequest, re
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			attr.Filter: filterBlock(attr.Name, attr.Address, attr.Tag),
+		},
 	}
 }
 
@@ -184,7 +238,31 @@ func (d *resources) Read(ctx context.Context, req datasource.ReadRequest, resp *
 		return
 	}
 
-	resources, err := d.client.ReadResourcesByName(client.WithCallerCtx(ctx, datasourceKey), &model.ResourcesFilter{
+	expression, err := buildFilterExpression(data.Filter)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, TwingateResources)
+
+		return
+	}
+
+	if name == "" {
+		name, filter = expression.PushDown(attr.Name)
+	}
+
+	query, err := newResourcesQuery(&data)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, TwingateResources)
+
+		return
+	}
+
+	// Access grants are only part of the full read.
+	readResources := d.client.ReadResourcesByName
+	if query.groupID != "" || query.securityPolicyID != "" {
+		readResources = d.client.ReadFullResourcesByName
+	}
+
+	resources, err := readResources(client.WithCallerCtx(ctx, datasourceKey), &model.ResourcesFilter{
 		Name:       &name,
 		NameFilter: filter,
 		Tags:       GetTags(data.Tags),
@@ -196,7 +274,7 @@ func (d *resources) Read(ctx context.Context, req datasource.ReadRequest, resp *
 	}
 
 	data.ID = types.StringValue("query resources by name: " + name)
-	data.Resources = convertResourcesToTerraform(resources)
+	data.Resources = convertResourcesToTerraform(applyFilter(utils.Filter(resources, query.match), expression))
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -215,3 +293,96 @@ func GetTags(rawTags types.Map) map[string]string {
 
 	return tags
 }
+
+// resourcesQuery holds the filters applied to the Resources returned by the API.
+type resourcesQuery struct {
+	matchAddress     func(address string) bool
+	groupID          string
+	securityPolicyID string
+	isActive         *bool
+}
+
+func newResourcesQuery(data *resourcesModel) (*resourcesQuery, error) {
+	query := &resourcesQuery{
+		groupID:          data.GroupID.ValueString(),
+		securityPolicyID: data.SecurityPolicyID.ValueString(),
+		isActive:         data.IsActive.ValueBoolPointer(),
+	}
+
+	if address := data.Address.ValueString(); address != "" {
+		matchAddress, err := newAddressMatcher(address, data.AddressMode.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		query.matchAddress = matchAddress
+	}
+
+	return query, nil
+}
+
+func (q *resourcesQuery) match(resource *model.Resource) bool {
+	if q.matchAddress != nil && !q.matchAddress(resource.Address) {
+		return false
+	}
+
+	if q.isActive != nil && *q.isActive != resource.IsActive {
+		return false
+	}
+
+	if q.groupID != "" && !slices.ContainsFunc(resource.GroupsAccess, func(access model.AccessGroup) bool {
+		return access.GroupID == q.groupID
+	}) {
+		return false
+	}
+
+	if q.securityPolicyID != "" && !usesSecurityPolicy(resource, q.securityPolicyID) {
+		return false
+	}
+
+	return true
+}
+
+func usesSecurityPolicy(resource *model.Resource, policyID string) bool {
+	if resource.SecurityPolicyID != nil && *resource.SecurityPolicyID == policyID {
+		return true
+	}
+
+	return slices.ContainsFunc(resource.GroupsAccess, func(access model.AccessGroup) bool {
+		return access.SecurityPolicyID != nil && *access.SecurityPolicyID == policyID
+	})
+}
+
+// newAddressMatcher returns a function reporting whether a Resource address matches value in the given mode.
+func newAddressMatcher(value, mode string) (func(address string) bool, error) {
+	switch mode {
+	case addressModeCIDRContains, addressModeCIDROverlaps:
+		queryPrefix, ok := utils.ParsePrefix(value)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q is not an IP address or CIDR range", ErrInvalidAddressFilter, value)
+		}
+
+		return func(address string) bool {
+			prefix, ok := utils.ParsePrefix(address)
+			if !ok {
+				return false
+			}
+
+			if mode == addressModeCIDRContains {
+				return utils.PrefixContains(queryPrefix, prefix)
+			}
+
+			return queryPrefix.Overlaps(prefix)
+		}, nil
+
+	case addressModeWildcard:
+		return func(address string) bool {
+			return utils.MatchWildcard(value, address) || utils.MatchWildcard(address, value)
+		}, nil
+
+	default:
+		return func(address string) bool {
+			return address == value
+		}, nil
+	}
+}
@@ -0,0 +1,105 @@
+package datasource
+
+import (
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourcesQuery(t *testing.T) {
+	policy := "policy-1"
+
+	resources := []*model.Resource{
+		{ID: "ip", Address: "10.20.1.5", IsActive: true, SecurityPolicyID: &policy},
+		{ID: "subnet", Address: "10.20.8.0/24", IsActive: true, GroupsAccess: []model.AccessGroup{{GroupID: "grp-1"}}},
+		{ID: "supernet", Address: "10.0.0.0/8", IsActive: false},
+		{ID: "other", Address: "192.168.0.1", IsActive: true, GroupsAccess: []model.AccessGroup{{GroupID: "grp-2", SecurityPolicyID: &policy}}},
+		{ID: "host", Address: "db.prod.example.com", IsActive: true},
+		{ID: "wildcard", Address: "*.staging.example.com", IsActive: true},
+	}
+
+	cases := []struct {
+		name     string
+		data     resourcesModel
+		expected []string
+	}{
+		{
+			name:     "no filters",
+			expected: []string{"ip", "subnet", "supernet", "other", "host", "wildcard"},
+		},
+		{
+			name:     "exact address",
+			data:     resourcesModel{Address: types.StringValue("10.20.8.0/24")},
+			expected: []string{"subnet"},
+		},
+		{
+			name:     "cidr contains",
+			data:     resourcesModel{Address: types.StringValue("10.20.0.0/16"), AddressMode: types.StringValue(addressModeCIDRContains)},
+			expected: []string{"ip", "subnet"},
+		},
+		{
+			name:     "cidr overlaps",
+			data:     resourcesModel{Address: types.StringValue("10.20.0.0/16"), AddressMode: types.StringValue(addressModeCIDROverlaps)},
+			expected: []string{"ip", "subnet", "supernet"},
+		},
+		{
+			name:     "wildcard pattern",
+			data:     resourcesModel{Address: types.StringValue("*.example.com"), AddressMode: types.StringValue(addressModeWildcard)},
+			expected: []string{"host", "wildcard"},
+		},
+		{
+			name:     "wildcard address covering a host",
+			data:     resourcesModel{Address: types.StringValue("api.staging.example.com"), AddressMode: types.StringValue(addressModeWildcard)},
+			expected: []string{"wildcard"},
+		},
+		{
+			name:     "group id",
+			data:     resourcesModel{GroupID: types.StringValue("grp-1")},
+			expected: []string{"subnet"},
+		},
+		{
+			name:     "security policy id",
+			data:     resourcesModel{SecurityPolicyID: types.StringValue(policy)},
+			expected: []string{"ip", "other"},
+		},
+		{
+			name:     "inactive",
+			data:     resourcesModel{IsActive: types.BoolValue(false)},
+			expected: []string{"supernet"},
+		},
+		{
+			name: "combined",
+			data: resourcesModel{
+				Address:     types.StringValue("10.0.0.0/8"),
+				AddressMode: types.StringValue(addressModeCIDRContains),
+				IsActive:    types.BoolValue(true),
+			},
+			expected: []string{"ip", "subnet"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			query, err := newResourcesQuery(&c.data)
+			assert.NoError(t, err)
+
+			ids := utils.Map(utils.Filter(resources, query.match), func(resource *model.Resource) string {
+				return resource.ID
+			})
+
+			assert.Equal(t, c.expected, ids)
+		})
+	}
+}
+
+func TestResourcesQueryInvalidCIDR(t *testing.T) {
+	_, err := newResourcesQuery(&resourcesModel{
+		Address:     types.StringValue("db.example.com"),
+		AddressMode: types.StringValue(addressModeCIDROverlaps),
+	})
+
+	assert.ErrorIs(t, err, ErrInvalidAddressFilter)
+}
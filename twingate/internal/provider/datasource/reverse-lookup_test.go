@@ -0,0 +1,53 @@
+package datasource
+
+import (
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourcesGrantedToGroup(t *testing.T) {
+	resources, _, _ := effectiveAccessFixtures()
+
+	assert.Equal(t, []string{"res-db", "res-app", "res-old"}, resourcesGrantedToGroup(resources, "grp-eng"))
+	assert.Equal(t, []string{"res-db"}, resourcesGrantedToGroup(resources, "grp-ops"))
+	assert.Empty(t, resourcesGrantedToGroup(resources, "grp-unknown"))
+}
+
+func TestRemoteNetworkReverseLookupFilters(t *testing.T) {
+	connectors := []*model.Connector{
+		{ID: "conn-1", NetworkID: "net-1"},
+		{ID: "conn-2", NetworkID: "net-2"},
+		{ID: "conn-3", NetworkID: "net-1"},
+	}
+
+	gateways := []*model.Gateway{
+		{ID: "gw-1", RemoteNetworkID: "net-2"},
+		{ID: "gw-2", RemoteNetworkID: "net-1"},
+	}
+
+	assert.Equal(t, []string{"conn-1", "conn-3"}, connectorsInNetwork(connectors, "net-1"))
+	assert.Equal(t, []string{"gw-2"}, gatewaysInNetwork(gateways, "net-1"))
+	assert.Empty(t, connectorsInNetwork(connectors, "net-3"))
+	assert.Empty(t, gatewaysInNetwork(nil, "net-1"))
+}
+
+func TestSecurityPolicyReverseLookup(t *testing.T) {
+	resources, _, _ := effectiveAccessFixtures()
+	groupPolicy := "policy-group"
+	resources = append(resources, &model.Resource{
+		ID:           "res-api",
+		GroupsAccess: []model.AccessGroup{{GroupID: "grp-qa", SecurityPolicyID: &groupPolicy}},
+	})
+
+	assert.Equal(t, []string{"res-db"}, resourcesUsingPolicy(resources, "policy-resource"))
+	assert.Empty(t, resourcesUsingPolicy(resources, "policy-group"))
+
+	assert.Equal(t, []accessGrantModel{
+		{ResourceID: types.StringValue("res-api"), GroupID: types.StringValue("grp-qa")},
+		{ResourceID: types.StringValue("res-db"), GroupID: types.StringValue("grp-ops")},
+	}, accessGrantsUsingPolicy(resources, "policy-group"))
+	assert.Equal(t, []accessGrantModel{}, accessGrantsUsingPolicy(resources, "policy-resource"))
+}
@@ -35,6 +35,7 @@ type securityPoliciesModel struct {
 	NamePrefix       types.String          `tfsdk:"name_prefix"`
 	NameSuffix       types.String          `tfsdk:"name_suffix"`
 	SecurityPolicies []securityPolicyModel `tfsdk:"security_policies"`
+	Filter           []filterModel         `tfsdk:"filter"`
 }
 
 func (d *securityPolicies) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -107,6 +108,9 @@ func (d *securityPolicies) Schema(ctx context.Context, req datasource.SchemaRequ
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			attr.Filter: filterBlock(attr.Name),
+		},
 	}
 }
 
@@ -128,6 +132,17 @@ func (d *securityPolicies) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	expression, err := buildFilterExpression(data.Filter)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, TwingateSecurityPolicies)
+
+		return
+	}
+
+	if name == "" {
+		name, filter = expression.PushDown(attr.Name)
+	}
+
 	policies, err := d.client.ReadSecurityPolicies(ctx, name, filter)
 	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
 		addErr(&resp.Diagnostics, err, TwingateSecurityPolicy)
@@ -136,7 +151,7 @@ func (d *securityPolicies) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	data.ID = types.StringValue("security-policies-all")
-	data.SecurityPolicies = convertSecurityPoliciesToTerraform(policies)
+	data.SecurityPolicies = convertSecurityPoliciesToTerraform(applyFilter(policies, expression))
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -1,12 +1,17 @@
 package datasource
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -31,6 +36,19 @@ type securityPolicyModel struct {
 	Name types.String `tfsdk:"name"`
 }
 
+type securityPolicyLookupModel struct {
+	ID            types.String       `tfsdk:"id"`
+	Name          types.String       `tfsdk:"name"`
+	ReverseLookup types.Bool         `tfsdk:"reverse_lookup"`
+	ResourceIDs   types.Set          `tfsdk:"resource_ids"`
+	AccessGrants  []accessGrantModel `tfsdk:"access_grants"`
+}
+
+type accessGrantModel struct {
+	ResourceID types.String `tfsdk:"resource_id"`
+	GroupID    types.String `tfsdk:"group_id"`
+}
+
 func (d *securityPolicy) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = TwingateSecurityPolicy
 }
@@ -75,12 +93,38 @@ func (d *securityPolicy) Schema(ctx context.Context, req datasource.SchemaReques
 					}...),
 				},
 			},
+			attr.ReverseLookup: schema.BoolAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Set to `true` to populate `%s` and `%s`. This reads every Resource in the account, so it's off by default.", attr.ResourceIDs, attr.AccessGrants),
+			},
+			// computed
+			attr.ResourceIDs: schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: fmt.Sprintf("The IDs of the Resources that use the Security Policy as their default policy. Only set when `%s` is `true`.", attr.ReverseLookup),
+			},
+			attr.AccessGrants: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("The group access grants that override their Resource's policy with the Security Policy. Only set when `%s` is `true`.", attr.ReverseLookup),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.ResourceID: schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Resource.",
+						},
+						attr.GroupID: schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Group granted access to the Resource.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func (d *securityPolicy) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data securityPolicyModel
+	var data securityPolicyLookupModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -98,7 +142,56 @@ func (d *securityPolicy) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	data.ID = types.StringValue(policy.ID)
 	data.Name = types.StringValue(policy.Name)
+	data.ResourceIDs = types.SetNull(types.StringType)
+
+	if data.ReverseLookup.ValueBool() {
+		resources, err := d.client.ReadFullResources(client.WithCallerCtx(ctx, datasourceKey))
+		if err != nil {
+			addErr(&resp.Diagnostics, err, TwingateSecurityPolicy)
+
+			return
+		}
+
+		data.ResourceIDs = utils.MakeStringSet(resourcesUsingPolicy(resources, policy.ID))
+		data.AccessGrants = accessGrantsUsingPolicy(resources, policy.ID)
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// resourcesUsingPolicy returns the IDs of the Resources whose default Security Policy is policyID.
+func resourcesUsingPolicy(resources []*model.Resource, policyID string) []string {
+	return utils.FilterMap(resources,
+		func(resource *model.Resource) bool {
+			return resource.SecurityPolicyID != nil && *resource.SecurityPolicyID == policyID
+		},
+		func(resource *model.Resource) string {
+			return resource.ID
+		})
+}
+
+// accessGrantsUsingPolicy returns the group access grants that set policyID, sorted by Resource and Group ID.
+func accessGrantsUsingPolicy(resources []*model.Resource, policyID string) []accessGrantModel {
+	grants := make([]accessGrantModel, 0)
+
+	for _, resource := range resources {
+		for _, access := range resource.GroupsAccess {
+			if access.SecurityPolicyID != nil && *access.SecurityPolicyID == policyID {
+				grants = append(grants, accessGrantModel{
+					ResourceID: types.StringValue(resource.ID),
+					GroupID:    types.StringValue(access.GroupID),
+				})
+			}
+		}
+	}
+
+	slices.SortFunc(grants, func(a, b accessGrantModel) int {
+		return cmp.Or(
+			strings.Compare(a.ResourceID.ValueString(), b.ResourceID.ValueString()),
+			strings.Compare(a.GroupID.ValueString(), b.GroupID.ValueString()),
+		)
+	})
+
+	return grants
+}
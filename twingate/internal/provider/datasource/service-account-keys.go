@@ -0,0 +1,223 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSource = &serviceAccountKeys{}
+
+func NewServiceAccountKeysDatasource() datasource.DataSource {
+	return &serviceAccountKeys{}
+}
+
+type serviceAccountKeys struct {
+	client *client.Client
+}
+
+type serviceAccountKeysModel struct {
+	ID               types.String             `tfsdk:"id"`
+	ServiceAccountID types.String             `tfsdk:"service_account_id"`
+	Status           types.String             `tfsdk:"status"`
+	ExpiringWithin   types.Int64              `tfsdk:"expiring_within"`
+	Keys             []serviceAccountKeyModel `tfsdk:"keys"`
+}
+
+type serviceAccountKeyModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	ServiceAccountID types.String `tfsdk:"service_account_id"`
+	Status           types.String `tfsdk:"status"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+	DaysRemaining    types.Int64  `tfsdk:"days_remaining"`
+}
+
+func (d *serviceAccountKeys) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = TwingateServiceAccountKeys
+}
+
+func (d *serviceAccountKeys) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *serviceAccountKeys) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the keys of Service Accounts together with their expiry, e.g. to catch keys that are about to expire.",
+		Attributes: map[string]schema.Attribute{
+			attr.ID: schema.StringAttribute{
+				Computed:    true,
+				Description: computedDatasourceIDDescription,
+			},
+			attr.ServiceAccountID: schema.StringAttribute{
+				Optional:    true,
+				Description: "Returns only the keys of this Service Account, which must exist. If not set, the keys of every Service Account are returned.",
+			},
+			attr.Status: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Returns only keys with this status, e.g. `%s` or `%s`.", model.StatusActive, model.StatusRevoked),
+			},
+			attr.ExpiringWithin: schema.Int64Attribute{
+				Optional:    true,
+				Description: "Returns only keys expiring within this number of days, including keys that have already expired. Keys that never expire are excluded.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			attr.Keys: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of Service Account keys",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.ID: schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Service Account key.",
+						},
+						attr.Name: schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the Service Account key.",
+						},
+						attr.ServiceAccountID: schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Service Account the key belongs to.",
+						},
+						attr.Status: schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the key.",
+						},
+						attr.ExpiresAt: schema.StringAttribute{
+							Computed:    true,
+							Description: "When the key expires, as an RFC 3339 timestamp. Empty when the key never expires.",
+						},
+						attr.DaysRemaining: schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of days until the key expires, rounded up. Zero or negative once it has expired, and null when the key never expires.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *serviceAccountKeys) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data serviceAccountKeysModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, err := d.client.ReadServiceKeys(ctx, data.ServiceAccountID.ValueString())
+	if err != nil {
+		addErr(&resp.Diagnostics, err, TwingateServiceAccountKeys)
+
+		return
+	}
+
+	now := time.Now()
+	query := serviceAccountKeysQuery{
+		status:         data.Status.ValueString(),
+		expiringWithin: data.ExpiringWithin.ValueInt64Pointer(),
+		now:            now,
+	}
+
+	data.ID = types.StringValue(terraformServiceAccountKeysDatasourceID(data.ServiceAccountID.ValueString()))
+	data.Keys = convertServiceAccountKeysToTerraform(utils.Filter(keys, query.match), now)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func terraformServiceAccountKeysDatasourceID(serviceAccountID string) string {
+	if serviceAccountID != "" {
+		return "service-account-keys-" + serviceAccountID
+	}
+
+	return "all-service-account-keys"
+}
+
+// serviceAccountKeysQuery holds the filters applied to the keys returned by the API.
+type serviceAccountKeysQuery struct {
+	status         string
+	expiringWithin *int64
+	now            time.Time
+}
+
+func (q serviceAccountKeysQuery) match(key *model.ServiceKey) bool {
+	if q.status != "" && key.Status != q.status {
+		return false
+	}
+
+	if q.expiringWithin != nil {
+		days, ok := daysRemaining(key.ExpiresAt, q.now)
+		if !ok || days > *q.expiringWithin {
+			return false
+		}
+	}
+
+	return true
+}
+
+// daysRemaining returns the number of days until expiresAt, rounded up, and false when the key never expires.
+func daysRemaining(expiresAt string, now time.Time) (int64, bool) {
+	if expiresAt == "" {
+		return 0, false
+	}
+
+	expiry, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return 0, false
+	}
+
+	const day = 24 * time.Hour
+
+	return int64(math.Ceil(float64(expiry.Sub(now)) / float64(day))), true
+}
+
+func convertServiceAccountKeysToTerraform(keys []*model.ServiceKey, now time.Time) []serviceAccountKeyModel {
+	return utils.Map(keys, func(key *model.ServiceKey) serviceAccountKeyModel {
+		days := types.Int64Null()
+		if value, ok := daysRemaining(key.ExpiresAt, now); ok {
+			days = types.Int64Value(value)
+		}
+
+		return serviceAccountKeyModel{
+			ID:               types.StringValue(key.ID),
+			Name:             types.StringValue(key.Name),
+			ServiceAccountID: types.StringValue(key.Service),
+			Status:           types.StringValue(key.Status),
+			ExpiresAt:        types.StringValue(key.ExpiresAt),
+			DaysRemaining:    days,
+		}
+	})
+}
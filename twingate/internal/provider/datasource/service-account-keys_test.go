@@ -0,0 +1,114 @@
+package datasource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceAccountKeysQuery(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	within := func(days int64) *int64 { return &days }
+
+	keys := []*model.ServiceKey{
+		{ID: "never", Status: model.StatusActive},
+		{ID: "soon", Status: model.StatusActive, ExpiresAt: "2026-01-10T00:00:00Z"},
+		{ID: "later", Status: model.StatusActive, ExpiresAt: "2026-03-01T00:00:00Z"},
+		{ID: "expired", Status: model.StatusActive, ExpiresAt: "2025-12-01T00:00:00Z"},
+		{ID: "revoked", Status: model.StatusRevoked, ExpiresAt: "2026-01-05T00:00:00Z"},
+	}
+
+	cases := []struct {
+		name     string
+		query    serviceAccountKeysQuery
+		expected []string
+	}{
+		{
+			name:     "no filters",
+			expected: []string{"never", "soon", "later", "expired", "revoked"},
+		},
+		{
+			name:     "status",
+			query:    serviceAccountKeysQuery{status: model.StatusRevoked},
+			expected: []string{"revoked"},
+		},
+		{
+			name:     "expiring within 14 days",
+			query:    serviceAccountKeysQuery{expiringWithin: within(14), now: now},
+			expected: []string{"soon", "expired", "revoked"},
+		},
+		{
+			name:     "active keys expiring within 14 days",
+			query:    serviceAccountKeysQuery{status: model.StatusActive, expiringWithin: within(14), now: now},
+			expected: []string{"soon", "expired"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ids := utils.Map(utils.Filter(keys, c.query.match), func(key *model.ServiceKey) string {
+				return key.ID
+			})
+
+			assert.Equal(t, c.expected, ids)
+		})
+	}
+}
+
+func TestDaysRemaining(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		expiresAt string
+		expected  int64
+		ok        bool
+	}{
+		{expiresAt: "", ok: false},
+		{expiresAt: "invalid", ok: false},
+		{expiresAt: "2026-01-01T13:00:00Z", expected: 1, ok: true},
+		{expiresAt: "2026-01-15T12:00:00Z", expected: 14, ok: true},
+		{expiresAt: "2026-01-01T11:00:00Z", expected: 0, ok: true},
+		{expiresAt: "2025-12-30T12:00:00Z", expected: -2, ok: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.expiresAt, func(t *testing.T) {
+			days, ok := daysRemaining(c.expiresAt, now)
+
+			assert.Equal(t, c.ok, ok)
+			assert.Equal(t, c.expected, days)
+		})
+	}
+}
+
+func TestConvertServiceAccountKeysToTerraform(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	keys := []*model.ServiceKey{
+		{ID: "key-1", Name: "ci", Service: "account-1", Status: model.StatusActive, ExpiresAt: "2026-01-11T12:00:00Z"},
+		{ID: "key-2", Name: "forever", Service: "account-1", Status: model.StatusActive},
+	}
+
+	assert.Equal(t, []serviceAccountKeyModel{
+		{
+			ID:               types.StringValue("key-1"),
+			Name:             types.StringValue("ci"),
+			ServiceAccountID: types.StringValue("account-1"),
+			Status:           types.StringValue(model.StatusActive),
+			ExpiresAt:        types.StringValue("2026-01-11T12:00:00Z"),
+			DaysRemaining:    types.Int64Value(10),
+		},
+		{
+			ID:               types.StringValue("key-2"),
+			Name:             types.StringValue("forever"),
+			ServiceAccountID: types.StringValue("account-1"),
+			Status:           types.StringValue(model.StatusActive),
+			ExpiresAt:        types.StringValue(""),
+			DaysRemaining:    types.Int64Null(),
+		},
+	}, convertServiceAccountKeysToTerraform(keys, now))
+}
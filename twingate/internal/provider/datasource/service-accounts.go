@@ -35,6 +35,7 @@ type serviceAccountsModel struct {
 	NamePrefix      types.String          `tfsdk:"name_prefix"`
 	NameSuffix      types.String          `tfsdk:"name_suffix"`
 	ServiceAccounts []serviceAccountModel `tfsdk:"service_accounts"`
+	Filter          []filterModel         `tfsdk:"filter"`
 }
 
 type serviceAccountModel struct {
@@ -125,6 +126,9 @@ func (d *serviceAccounts) Schema(ctx context.Context, req datasource.SchemaReque
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			attr.Filter: filterBlock(attr.Name),
+		},
 	}
 }
 
@@ -146,6 +150,17 @@ func (d *serviceAccounts) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	expression, err := buildFilterExpression(data.Filter)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, TwingateServiceAccounts)
+
+		return
+	}
+
+	if name == "" {
+		name, filter = expression.PushDown(attr.Name)
+	}
+
 	accounts, err := d.client.ReadServiceAccounts(ctx, name, filter)
 	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
 		addErr(&resp.Diagnostics, err, TwingateServiceAccounts)
@@ -154,7 +169,7 @@ func (d *serviceAccounts) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	data.ID = types.StringValue(terraformServicesDatasourceID(data.Name.ValueString()))
-	data.ServiceAccounts = convertServicesToTerraform(accounts)
+	data.ServiceAccounts = convertServicesToTerraform(applyFilter(accounts, expression))
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -34,6 +34,7 @@ type userModel struct {
 	Email     types.String `tfsdk:"email"`
 	Role      types.String `tfsdk:"role"`
 	Type      types.String `tfsdk:"type"`
+	State     types.String `tfsdk:"state"`
 }
 
 func (d *user) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -87,6 +88,10 @@ func (d *user) Schema(ctx context.Context, req datasource.SchemaRequest, resp *d
 				Computed:    true,
 				Description: fmt.Sprintf("Indicates the User's type. Either %s.", utils.DocList(model.UserTypes)),
 			},
+			attr.State: schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Indicates the User's state. Either %s.", utils.DocList(model.UserStates)),
+			},
 		},
 	}
 }
@@ -114,6 +119,7 @@ func (d *user) Read(ctx context.Context, req datasource.ReadRequest, resp *datas
 	data.Email = types.StringValue(user.Email)
 	data.Role = types.StringValue(user.Role)
 	data.Type = types.StringValue(user.Type)
+	data.State = types.StringValue(user.State())
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
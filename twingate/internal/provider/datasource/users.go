@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
@@ -37,27 +38,38 @@ type users struct {
 }
 
 type usersModel struct {
-	ID                types.String `tfsdk:"id"`
-	Email             types.String `tfsdk:"email"`
-	EmailRegexp       types.String `tfsdk:"email_regexp"`
-	EmailContains     types.String `tfsdk:"email_contains"`
-	EmailExclude      types.String `tfsdk:"email_exclude"`
-	EmailPrefix       types.String `tfsdk:"email_prefix"`
-	EmailSuffix       types.String `tfsdk:"email_suffix"`
-	FirstName         types.String `tfsdk:"first_name"`
-	FirstNameRegexp   types.String `tfsdk:"first_name_regexp"`
-	FirstNameContains types.String `tfsdk:"first_name_contains"`
-	FirstNameExclude  types.String `tfsdk:"first_name_exclude"`
-	FirstNamePrefix   types.String `tfsdk:"first_name_prefix"`
-	FirstNameSuffix   types.String `tfsdk:"first_name_suffix"`
-	LastName          types.String `tfsdk:"last_name"`
-	LastNameRegexp    types.String `tfsdk:"last_name_regexp"`
-	LastNameContains  types.String `tfsdk:"last_name_contains"`
-	LastNameExclude   types.String `tfsdk:"last_name_exclude"`
-	LastNamePrefix    types.String `tfsdk:"last_name_prefix"`
-	LastNameSuffix    types.String `tfsdk:"last_name_suffix"`
-	Roles             types.Set    `tfsdk:"roles"`
-	Users             []userModel  `tfsdk:"users"`
+	ID                types.String     `tfsdk:"id"`
+	Email             types.String     `tfsdk:"email"`
+	EmailRegexp       types.String     `tfsdk:"email_regexp"`
+	EmailContains     types.String     `tfsdk:"email_contains"`
+	EmailExclude      types.String     `tfsdk:"email_exclude"`
+	EmailPrefix       types.String     `tfsdk:"email_prefix"`
+	EmailSuffix       types.String     `tfsdk:"email_suffix"`
+	FirstName         types.String     `tfsdk:"first_name"`
+	FirstNameRegexp   types.String     `tfsdk:"first_name_regexp"`
+	FirstNameContains types.String     `tfsdk:"first_name_contains"`
+	FirstNameExclude  types.String     `tfsdk:"first_name_exclude"`
+	FirstNamePrefix   types.String     `tfsdk:"first_name_prefix"`
+	FirstNameSuffix   types.String     `tfsdk:"first_name_suffix"`
+	LastName          types.String     `tfsdk:"last_name"`
+	LastNameRegexp    types.String     `tfsdk:"last_name_regexp"`
+	LastNameContains  types.String     `tfsdk:"last_name_contains"`
+	LastNameExclude   types.String     `tfsdk:"last_name_exclude"`
+	LastNamePrefix    types.String     `tfsdk:"last_name_prefix"`
+	LastNameSuffix    types.String     `tfsdk:"last_name_suffix"`
+	Roles             types.Set        `tfsdk:"roles"`
+	State             types.String     `tfsdk:"state"`
+	Type              types.String     `tfsdk:"type"`
+	GroupID           types.String     `tfsdk:"group_id"`
+	ReverseLookup     types.Bool       `tfsdk:"reverse_lookup"`
+	Users             []usersItemModel `tfsdk:"users"`
+	Filter            []filterModel    `tfsdk:"filter"`
+}
+
+type usersItemModel struct {
+	userModel
+
+	GroupIDs types.Set `tfsdk:"group_ids"`
 }
 
 func (d *users) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -181,6 +193,28 @@ func (d *users) Schema(ctx context.Context, req datasource.SchemaRequest, resp *
 					setvalidator.ValueStringsAre(stringvalidator.OneOf(model.UserRoles...)),
 				},
 			},
+			attr.State: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Returns only users in this state. Either %s.", utils.DocList(model.UserStates)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.UserStates...),
+				},
+			},
+			attr.Type: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Returns only users of this type. Either %s.", utils.DocList(model.UserTypes)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.UserTypes...),
+				},
+			},
+			attr.GroupID: schema.StringAttribute{
+				Optional:    true,
+				Description: "Returns only members of this Group.",
+			},
+			attr.ReverseLookup: schema.BoolAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Set to `true` to populate `%s` on each user. This reads every Group in the account, so it's off by default.", attr.GroupIDs),
+			},
 
 			attr.Users: schema.ListNestedAttribute{
 				Computed: true,
@@ -210,10 +244,22 @@ func (d *users) Schema(ctx context.Context, req datasource.SchemaRequest, resp *
 							Computed:    true,
 							Description: fmt.Sprintf("Indicates the User's type. Either %s.", utils.DocList(model.UserTypes)),
 						},
+						attr.State: schema.StringAttribute{
+							Computed:    true,
+							Description: fmt.Sprintf("Indicates the User's state. Either %s.", utils.DocList(model.UserStates)),
+						},
+						attr.GroupIDs: schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: fmt.Sprintf("The IDs of the Groups the User belongs to. Only set when `%s` is `true`.", attr.ReverseLookup),
+						},
 					},
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			attr.Filter: filterBlock(attr.Email, attr.FirstName, attr.LastName, attr.Role, attr.Type, attr.State),
+		},
 	}
 }
 
@@ -254,6 +300,25 @@ func (d *users) Read(ctx context.Context, req datasource.ReadRequest, resp *data
 		return
 	}
 
+	expression, err := buildFilterExpression(data.Filter)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, TwingateUsers)
+
+		return
+	}
+
+	if email == "" {
+		email, emailFilter = expression.PushDown(attr.Email)
+	}
+
+	if firstName == "" {
+		firstName, firstNameFilter = expression.PushDown(attr.FirstName)
+	}
+
+	if lastName == "" {
+		lastName, lastNameFilter = expression.PushDown(attr.LastName)
+	}
+
 	var filter *client.UsersFilter
 
 	if email != "" || firstName != "" || lastName != "" || len(data.Roles.Elements()) > 0 {
@@ -285,9 +350,90 @@ func (d *users) Read(ctx context.Context, req datasource.ReadRequest, resp *data
 		return
 	}
 
+	query := usersQuery{
+		state:    data.State.ValueString(),
+		userType: data.Type.ValueString(),
+	}
+
+	if groupID := data.GroupID.ValueString(); groupID != "" {
+		group, err := d.client.ReadGroup(client.WithCallerCtx(ctx, datasourceKey), groupID)
+		if err != nil {
+			addErr(&resp.Diagnostics, err, TwingateUsers)
+
+			return
+		}
+
+		query.members = group.Users
+		query.filterByGroup = true
+	}
+
+	users = utils.Filter(applyFilter(users, expression), query.match)
+
+	var userGroups map[string][]string
+
+	if data.ReverseLookup.ValueBool() {
+		groups, err := d.client.ReadFullGroups(client.WithCallerCtx(ctx, datasourceKey))
+		if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+			addErr(&resp.Diagnostics, err, TwingateUsers)
+
+			return
+		}
+
+		userGroups = groupsByUser(groups)
+	}
+
 	data.ID = types.StringValue("users-all")
-	data.Users = convertUsersToTerraform(users)
+	data.Users = utils.Map(convertUsersToTerraform(users), func(user userModel) usersItemModel {
+		item := usersItemModel{userModel: user, GroupIDs: types.SetNull(types.StringType)}
+
+		if userGroups != nil {
+			item.GroupIDs = utils.MakeStringSet(userGroups[user.ID.ValueString()])
+		}
+
+		return item
+	})
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// usersQuery holds the filters applied to the users returned by the API.
+type usersQuery struct {
+	state         string
+	userType      string
+	members       []string
+	filterByGroup bool
+}
+
+func (q usersQuery) match(user *model.User) bool {
+	if q.state != "" && user.State() != q.state {
+		return false
+	}
+
+	if q.userType != "" && user.Type != q.userType {
+		return false
+	}
+
+	if q.filterByGroup && !slices.Contains(q.members, user.ID) {
+		return false
+	}
+
+	return true
+}
+
+// groupsByUser returns the IDs of the Groups each user belongs to, sorted.
+func groupsByUser(groups []*model.Group) map[string][]string {
+	userGroups := make(map[string][]string)
+
+	for _, group := range groups {
+		for _, userID := range group.Users {
+			userGroups[userID] = append(userGroups[userID], group.ID)
+		}
+	}
+
+	for _, groupIDs := range userGroups {
+		slices.Sort(groupIDs)
+	}
+
+	return userGroups
+}
@@ -0,0 +1,76 @@
+package datasource
+
+import (
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsersQuery(t *testing.T) {
+	users := []*model.User{
+		{ID: "active", Type: model.UserTypeManual, IsActive: true},
+		{ID: "pending", Type: model.UserTypeSynced, IsActive: true, IsPending: true},
+		{ID: "disabled", Type: model.UserTypeSynced},
+	}
+
+	cases := []struct {
+		name     string
+		query    usersQuery
+		expected []string
+	}{
+		{
+			name:     "no filters",
+			expected: []string{"active", "pending", "disabled"},
+		},
+		{
+			name:     "pending state",
+			query:    usersQuery{state: model.UserStatePending},
+			expected: []string{"pending"},
+		},
+		{
+			name:     "synced type",
+			query:    usersQuery{userType: model.UserTypeSynced},
+			expected: []string{"pending", "disabled"},
+		},
+		{
+			name:     "group members",
+			query:    usersQuery{members: []string{"active", "disabled"}, filterByGroup: true},
+			expected: []string{"active", "disabled"},
+		},
+		{
+			name:     "group without members",
+			query:    usersQuery{filterByGroup: true},
+			expected: []string{},
+		},
+		{
+			name:     "disabled group members",
+			query:    usersQuery{state: model.UserStateDisabled, members: []string{"active", "disabled"}, filterByGroup: true},
+			expected: []string{"disabled"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ids := utils.Map(utils.Filter(users, c.query.match), func(user *model.User) string {
+				return user.ID
+			})
+
+			assert.Equal(t, c.expected, ids)
+		})
+	}
+}
+
+func TestGroupsByUser(t *testing.T) {
+	groups := []*model.Group{
+		{ID: "grp-2", Users: []string{"user-1", "user-2"}},
+		{ID: "grp-1", Users: []string{"user-1"}},
+		{ID: "grp-3"},
+	}
+
+	assert.Equal(t, map[string][]string{
+		"user-1": {"grp-1", "grp-2"},
+		"user-2": {"grp-2"},
+	}, groupsByUser(groups))
+}
@@ -4,12 +4,15 @@ package datasource
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -25,9 +28,16 @@ type x509CertificateAuthority struct {
 }
 
 type x509CertificateAuthorityDatasourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Fingerprint types.String `tfsdk:"fingerprint"`
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Certificate  types.String `tfsdk:"certificate"`
+	Fingerprint  types.String `tfsdk:"fingerprint"`
+	Subject      types.String `tfsdk:"subject"`
+	Issuer       types.String `tfsdk:"issuer"`
+	NotBefore    types.String `tfsdk:"not_before"`
+	NotAfter     types.String `tfsdk:"not_after"`
+	Serial       types.String `tfsdk:"serial"`
+	KeyAlgorithm types.String `tfsdk:"key_algorithm"`
 }
 
 func (d *x509CertificateAuthority) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -60,6 +70,10 @@ func (d *x509CertificateAuthority) Schema(_ context.Context, _ datasource.Schema
 				Required:    true,
 				Description: "The ID of the X509 Certificate Authority.",
 			},
+			attr.Certificate: schema.StringAttribute{
+				Optional:    true,
+				Description: "The PEM-encoded certificate, chain or bundle the Certificate Authority was created from. Twingate only keeps the fingerprint, so the metadata attributes are only set when the certificate is given. It must match `fingerprint`.",
+			},
 			attr.Name: schema.StringAttribute{
 				Computed:    true,
 				Description: "The name of the X509 Certificate Authority.",
@@ -68,6 +82,30 @@ func (d *x509CertificateAuthority) Schema(_ context.Context, _ datasource.Schema
 				Computed:    true,
 				Description: "The SHA-256 fingerprint of the X509 certificate.",
 			},
+			attr.Subject: schema.StringAttribute{
+				Computed:    true,
+				Description: "The subject of the certificate, e.g. `CN=My CA,O=Example`.",
+			},
+			attr.Issuer: schema.StringAttribute{
+				Computed:    true,
+				Description: "The issuer of the certificate.",
+			},
+			attr.NotBefore: schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the certificate becomes valid, in RFC 3339 format.",
+			},
+			attr.NotAfter: schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the certificate expires, in RFC 3339 format.",
+			},
+			attr.Serial: schema.StringAttribute{
+				Computed:    true,
+				Description: "The serial number of the certificate as colon-separated hex pairs.",
+			},
+			attr.KeyAlgorithm: schema.StringAttribute{
+				Computed:    true,
+				Description: "The algorithm and size of the certificate's public key, e.g. `RSA-2048`, `ECDSA-P-256` or `Ed25519`.",
+			},
 		},
 	}
 }
@@ -100,5 +138,32 @@ func (d *x509CertificateAuthority) Read(ctx context.Context, req datasource.Read
 	data.Name = types.StringValue(certificateAuthority.Name)
 	data.Fingerprint = types.StringValue(certificateAuthority.Fingerprint)
 
+	if data.Certificate.ValueString() != "" {
+		certs, err := utils.ParseCertificates(data.Certificate.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attr.Certificate), "Invalid certificate", err.Error())
+
+			return
+		}
+
+		info := utils.NewCertificateInfo(certs[0])
+		if info.Fingerprint != certificateAuthority.Fingerprint {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr.Certificate),
+				"Certificate mismatch",
+				fmt.Sprintf("The certificate's fingerprint %s doesn't match the Certificate Authority's fingerprint %s.", info.Fingerprint, certificateAuthority.Fingerprint),
+			)
+
+			return
+		}
+
+		data.Subject = types.StringValue(info.Subject)
+		data.Issuer = types.StringValue(info.Issuer)
+		data.NotBefore = types.StringValue(info.NotBefore.Format(time.RFC3339))
+		data.NotAfter = types.StringValue(info.NotAfter.Format(time.RFC3339))
+		data.Serial = types.StringValue(info.Serial)
+		data.KeyAlgorithm = types.StringValue(info.KeyAlgorithm)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -2,10 +2,19 @@ package providerdata
 
 import "github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
 
+// How conflicts with other objects, e.g. overlapping twingate_resource addresses, are reported at plan time.
+const (
+	ConflictsWarn   = "warn"
+	ConflictsError  = "error"
+	ConflictsIgnore = "ignore"
+)
+
 type Config struct {
-	RegionalURL string
-	Network     string
-	URL         string
+	RegionalURL                          string
+	Network                              string
+	URL                                  string
+	ResourceAddressConflicts             string
+	DNSFilteringProfilePriorityConflicts string
 }
 
 type ProviderData struct {
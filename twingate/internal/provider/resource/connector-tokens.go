@@ -3,21 +3,34 @@ package resource
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/customvalidator"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// privateStateKeyTokensInvalid flags tokens Twingate rejected on the last refresh.
+const privateStateKeyTokensInvalid = "tokens_invalid"
+
 // Ensure the implementation satisfies the desired interfaces.
-var _ resource.Resource = &connectorTokens{}
+var (
+	_ resource.Resource               = &connectorTokens{}
+	_ resource.ResourceWithModifyPlan = &connectorTokens{}
+)
 
 func NewConnectorTokensResource() resource.Resource {
 	return &connectorTokens{}
@@ -28,11 +41,17 @@ type connectorTokens struct {
 }
 
 type connectorTokensModel struct {
-	ID           types.String `tfsdk:"id"`
-	ConnectorID  types.String `tfsdk:"connector_id"`
-	AccessToken  types.String `tfsdk:"access_token"`
-	RefreshToken types.String `tfsdk:"refresh_token"`
-	Keepers      types.Map    `tfsdk:"keepers"`
+	ID                 types.String   `tfsdk:"id"`
+	ConnectorID        types.String   `tfsdk:"connector_id"`
+	AccessToken        types.String   `tfsdk:"access_token"`
+	RefreshToken       types.String   `tfsdk:"refresh_token"`
+	Keepers            types.Map      `tfsdk:"keepers"`
+	RotationPeriod     types.String   `tfsdk:"rotation_period"`
+	SecretSink         types.Object   `tfsdk:"secret_sink"`
+	AccessTokenSHA256  types.String   `tfsdk:"access_token_sha256"`
+	RefreshTokenSHA256 types.String   `tfsdk:"refresh_token_sha256"`
+	VerifyOnRead       types.Bool     `tfsdk:"verify_on_read"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *connectorTokens) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,7 +71,7 @@ func (r *connectorTokens) Configure(_ context.Context, req resource.ConfigureReq
 	r.client = providerData.Client
 }
 
-func (r *connectorTokens) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *connectorTokens) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "This resource type will generate tokens for a Connector, which are needed to successfully provision one on your network. The Connector itself has its own resource type and must be created before you can provision tokens.",
 		Attributes: map[string]schema.Attribute{
@@ -72,17 +91,31 @@ func (r *connectorTokens) Schema(_ context.Context, _ resource.SchemaRequest, re
 				ElementType: types.StringType,
 				Description: "Arbitrary map of values that, when changed, will trigger recreation of resource. Use this to automatically rotate Connector tokens on a schedule.",
 			},
+			attr.RotationPeriod: schema.StringAttribute{
+				Optional:    true,
+				Description: "How long tokens are used before Terraform rotates them, e.g. `30d`. Rotation is planned on the first plan after the period has elapsed and invalidates the previous tokens.",
+				Validators:  []validator.String{customvalidator.Duration()},
+			},
+			attr.SecretSink: secretSinkAttribute(),
+			attr.VerifyOnRead: schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether to verify the tokens with Twingate on every refresh and plan their replacement when Twingate rejects them. A verification request that fails for another reason only raises a warning. Tokens handed to a `secret_sink` can't be verified. Default is `true`.",
+			},
 			// computed
 			attr.AccessToken: schema.StringAttribute{
 				Computed:    true,
 				Sensitive:   true,
-				Description: "The Access Token of the parent Connector",
+				Description: "The Access Token of the parent Connector. Not kept in state when `secret_sink` is configured.",
 			},
 			attr.RefreshToken: schema.StringAttribute{
 				Computed:    true,
 				Sensitive:   true,
-				Description: "The Refresh Token of the parent Connector",
+				Description: "The Refresh Token of the parent Connector. Not kept in state when `secret_sink` is configured.",
 			},
+			attr.AccessTokenSHA256:  secretHashAttribute("access token"),
+			attr.RefreshTokenSHA256: secretHashAttribute("refresh token"),
 			attr.ID: schema.StringAttribute{
 				Computed:    true,
 				Description: "The ID of this resource.",
@@ -91,6 +124,9 @@ func (r *connectorTokens) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			attr.Timeouts: timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -99,6 +135,9 @@ func (r *connectorTokens) Create(ctx context.Context, req resource.CreateRequest
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -111,8 +150,7 @@ func (r *connectorTokens) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	plan.ID = plan.ConnectorID
-	plan.AccessToken = types.StringValue(tokens.AccessToken)
-	plan.RefreshToken = types.StringValue(tokens.RefreshToken)
+	setConnectorTokens(&plan, tokens)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 
@@ -120,7 +158,8 @@ func (r *connectorTokens) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	r.helper(ctx, r.client, plan.ID.ValueString(), tokens.AccessToken, tokens.RefreshToken, &resp.State, resp.Diagnostics)
+	resp.Diagnostics.Append(setCreatedAt(ctx, resp.Private, time.Now())...)
+	resp.Diagnostics.Append(deliverConnectorTokens(ctx, req.Config, &plan, tokens)...)
 }
 
 func (r *connectorTokens) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -128,15 +167,138 @@ func (r *connectorTokens) Read(ctx context.Context, req resource.ReadRequest, re
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	r.helper(ctx, r.client, state.ID.ValueString(), state.AccessToken.ValueString(), state.RefreshToken.ValueString(), &resp.State, resp.Diagnostics)
+	if state.VerifyOnRead.IsNull() {
+		state.VerifyOnRead = types.BoolValue(true)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	}
+
+	r.verifyTokens(ctx, &state, resp.Private, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(ensureCreatedAt(ctx, req.Private, resp.Private)...)
 }
 
 func (r *connectorTokens) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// on update, we should re-create the resource
+	// other changes re-create the resource, so only a scheduled rotation or
+	// rotation_period, verify_on_read and timeouts changes get here
+	var plan, state connectorTokensModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the ID is only unknown when ModifyPlan scheduled a rotation
+	if plan.ID.IsUnknown() {
+		tokens, err := r.client.GenerateConnectorTokens(ctx, plan.ConnectorID.ValueString())
+		if err != nil {
+			addErr(&resp.Diagnostics, err, operationUpdate, TwingateConnectorTokens)
+
+			return
+		}
+
+		setConnectorTokens(&state, tokens)
+
+		resp.Diagnostics.Append(setCreatedAt(ctx, resp.Private, time.Now())...)
+		resp.Diagnostics.Append(deliverConnectorTokens(ctx, req.Config, &state, tokens)...)
+	}
+
+	state.RotationPeriod = plan.RotationPeriod
+	state.VerifyOnRead = plan.VerifyOnRead
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// setConnectorTokens keeps the tokens in state, or only their hashes when a secret sink is configured.
+func setConnectorTokens(state *connectorTokensModel, tokens *model.ConnectorTokens) {
+	if isSecretSinkSet(state.SecretSink) {
+		state.AccessToken = types.StringNull()
+		state.RefreshToken = types.StringNull()
+		state.AccessTokenSHA256 = secretHash(tokens.AccessToken)
+		state.RefreshTokenSHA256 = secretHash(tokens.RefreshToken)
+
+		return
+	}
+
+	state.AccessToken = types.StringValue(tokens.AccessToken)
+	state.RefreshToken = types.StringValue(tokens.RefreshToken)
+	state.AccessTokenSHA256 = types.StringNull()
+	state.RefreshTokenSHA256 = types.StringNull()
+}
+
+func deliverConnectorTokens(ctx context.Context, config tfsdk.Config, state *connectorTokensModel, tokens *model.ConnectorTokens) diag.Diagnostics {
+	if !isSecretSinkSet(state.SecretSink) {
+		return nil
+	}
+
+	return deliverSecrets(ctx, config, map[string]string{
+		attr.AccessToken:  tokens.AccessToken,
+		attr.RefreshToken: tokens.RefreshToken,
+	})
+}
+
+// ModifyPlan replaces tokens that have been invalidated and schedules a rotation once the
+// tokens are older than rotation_period.
+func (r *connectorTokens) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to rotate on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan connectorTokensModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	invalid, diags := req.Private.GetKey(ctx, privateStateKeyTokensInvalid)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(invalid) > 0 && plan.VerifyOnRead.ValueBool() {
+		setConnectorTokensUnknown(&plan)
+
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root(attr.ID))
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+
+		return
+	}
+
+	createdAt, diags := getCreatedAt(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !isRotationDue(time.Now(), createdAt, optionalDuration(plan.RotationPeriod), 0, 0) {
+		return
+	}
+
+	setConnectorTokensUnknown(&plan)
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func setConnectorTokensUnknown(plan *connectorTokensModel) {
+	plan.ID = types.StringUnknown()
+	plan.AccessToken = types.StringUnknown()
+	plan.RefreshToken = types.StringUnknown()
+	plan.AccessTokenSHA256 = types.StringUnknown()
+	plan.RefreshTokenSHA256 = types.StringUnknown()
 }
 
 func (r *connectorTokens) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -144,6 +306,9 @@ func (r *connectorTokens) Delete(ctx context.Context, req resource.DeleteRequest
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -153,16 +318,37 @@ func (r *connectorTokens) Delete(ctx context.Context, req resource.DeleteRequest
 	addErr(&resp.Diagnostics, err, operationDelete, TwingateConnectorTokens)
 }
 
-func (r *connectorTokens) helper(ctx context.Context, client *client.Client, connectorID, accessToken, refreshToken string, state *tfsdk.State, diagnostics diag.Diagnostics) {
-	err := client.VerifyConnectorTokens(ctx, refreshToken, accessToken)
+// verifyTokens checks the tokens with Twingate and flags them in private state when they have been
+// invalidated, so that ModifyPlan replaces them. Only a rejection of the tokens flags them, a failed
+// request is reported as a warning and leaves the flag from the last refresh as it is.
+func (r *connectorTokens) verifyTokens(ctx context.Context, state *connectorTokensModel, private privateStateSetter, diagnostics *diag.Diagnostics) {
+	// tokens handed to a secret sink are not in state and can't be verified
+	if !state.VerifyOnRead.ValueBool() || isSecretSinkSet(state.SecretSink) {
+		return
+	}
+
+	err := r.client.VerifyConnectorTokens(ctx, state.RefreshToken.ValueString(), state.AccessToken.ValueString())
+	if err != nil && !client.IsConnectorTokensInvalid(err) {
+		diagnostics.AddWarning(
+			"Connector tokens could not be verified",
+			fmt.Sprintf("Failed to verify the tokens of connector %s, they are kept as they are: %s", state.ID.ValueString(), err.Error()),
+		)
+
+		return
+	}
+
+	var invalid []byte
+
 	if err != nil {
-		state.RemoveResource(ctx)
+		invalid = []byte("true")
 
 		diagnostics.AddWarning(
-			"can't to verify connector tokens",
-			fmt.Sprintf("can't verify connector %s tokens, assuming not valid and needs to be recreated", connectorID),
+			"Connector tokens are no longer valid",
+			fmt.Sprintf("Twingate rejected the tokens of connector %s, they will be replaced.", state.ID.ValueString()),
 		)
 	}
+
+	diagnostics.Append(private.SetKey(ctx, privateStateKeyTokensInvalid, invalid)...)
 }
 
 func RequiresMapReplace(description string) *requiresMapReplace {
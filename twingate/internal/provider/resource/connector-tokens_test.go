@@ -0,0 +1,61 @@
+package resource
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectorTokensVerifyTokens(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		name            string
+		status          int
+		previousInvalid []byte
+		expectedInvalid []byte
+		expectedWarning bool
+	}{
+		{name: "valid tokens clear the flag", status: http.StatusOK, previousInvalid: []byte("true")},
+		{name: "rejected tokens are flagged", status: http.StatusUnauthorized, expectedInvalid: []byte("true"), expectedWarning: true},
+		{name: "forbidden tokens are flagged", status: http.StatusForbidden, expectedInvalid: []byte("true"), expectedWarning: true},
+		{name: "failed request keeps valid tokens", status: http.StatusInternalServerError, expectedWarning: true},
+		{name: "failed request keeps the flag", status: http.StatusInternalServerError, previousInvalid: []byte("true"), expectedInvalid: []byte("true"), expectedWarning: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			apiClient := client.NewClient(t.Context(), "https://test.twindev.com", "xxxx", time.Second, 0, client.DefaultAgent, "test", client.CacheOptions{})
+			httpmock.ActivateNonDefault(apiClient.HTTPClient)
+			t.Cleanup(httpmock.DeactivateAndReset)
+
+			httpmock.RegisterResponder("POST", apiClient.APIServerURL+"/connector/validate_tokens",
+				httpmock.NewStringResponder(c.status, `{}`))
+
+			r := &connectorTokens{client: apiClient}
+			state := &connectorTokensModel{
+				ID:           types.StringValue("connector-1"),
+				AccessToken:  types.StringValue("access"),
+				RefreshToken: types.StringValue("refresh"),
+				VerifyOnRead: types.BoolValue(true),
+				SecretSink:   types.ObjectNull(nil),
+			}
+
+			private := testPrivateState{privateStateKeyTokensInvalid: c.previousInvalid}
+
+			var diagnostics diag.Diagnostics
+			r.verifyTokens(ctx, state, private, &diagnostics)
+
+			assert.False(t, diagnostics.HasError(), diagnostics)
+			assert.Equal(t, c.expectedWarning, diagnostics.WarningsCount() > 0)
+			assert.Equal(t, c.expectedInvalid, private[privateStateKeyTokensInvalid])
+		})
+	}
+}
@@ -6,14 +6,17 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -26,9 +29,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-const minLengthConnectorName = 3
+const (
+	minLengthConnectorName = 3
 
-var ErrNotAllowChangeRemoteNetworkID = errors.New("connectors cannot be moved between Remote Networks: you must either create a new Connector or destroy and recreate the existing one")
+	// defaultConnectorWaitTimeout bounds waiting for `wait_for_state` when no timeout is configured.
+	defaultConnectorWaitTimeout = 10 * time.Minute
+)
+
+var (
+	ErrNotAllowChangeRemoteNetworkID = errors.New("connectors cannot be moved between Remote Networks: you must either create a new Connector or destroy and recreate the existing one")
+	ErrConnectorStateNotReached      = errors.New("connector did not reach the expected state")
+)
+
+// connectorStatePollInterval is a variable so tests can poll faster.
+var connectorStatePollInterval = 10 * time.Second //nolint:gochecknoglobals
 
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.Resource = &connector{}
@@ -45,15 +59,18 @@ type connector struct {
 }
 
 type connectorModel struct {
-	ID                   types.String `tfsdk:"id"`
-	Name                 types.String `tfsdk:"name"`
-	RemoteNetworkID      types.String `tfsdk:"remote_network_id"`
-	StatusUpdatesEnabled types.Bool   `tfsdk:"status_updates_enabled"`
-	State                types.String `tfsdk:"state"`
-	Hostname             types.String `tfsdk:"hostname"`
-	Version              types.String `tfsdk:"version"`
-	PublicIP             types.String `tfsdk:"public_ip"`
-	PrivateIPs           types.Set    `tfsdk:"private_ips"`
+	ID                   types.String   `tfsdk:"id"`
+	Name                 types.String   `tfsdk:"name"`
+	RemoteNetworkID      types.String   `tfsdk:"remote_network_id"`
+	StatusUpdatesEnabled types.Bool     `tfsdk:"status_updates_enabled"`
+	State                types.String   `tfsdk:"state"`
+	Hostname             types.String   `tfsdk:"hostname"`
+	Version              types.String   `tfsdk:"version"`
+	PublicIP             types.String   `tfsdk:"public_ip"`
+	PrivateIPs           types.Set      `tfsdk:"private_ips"`
+	DeletionProtection   types.Bool     `tfsdk:"deletion_protection"`
+	WaitForState         types.String   `tfsdk:"wait_for_state"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *connector) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -104,7 +121,7 @@ func (r *connector) ModifyPlan(ctx context.Context, req resource.ModifyPlanReque
 	}
 }
 
-func (r *connector) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *connector) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Connectors provide connectivity to Remote Networks. This resource type will create the Connector in the Twingate Admin Console, but in order to successfully deploy it, you must also generate Connector tokens that authenticate the Connector with Twingate. For more information, see Twingate's [documentation](https://docs.twingate.com/docs/understanding-access-nodes).",
 		Attributes: map[string]schema.Attribute{
@@ -131,6 +148,14 @@ func (r *connector) Schema(_ context.Context, _ resource.SchemaRequest, resp *re
 				Computed:    true,
 				Description: "Determines whether status notifications are enabled for the Connector. Default is `true`.",
 			},
+			attr.DeletionProtection: deletionProtectionAttribute(),
+			attr.WaitForState: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("When set to `%[1]s` on an existing Connector, the update waits until the Connector reports this state, e.g. to sequence a blue/green Connector replacement once the new Connector is deployed. Creating a Connector never waits, even with this attribute set, as the Connector can only come up after its `twingate_connector_tokens`, which depend on it, are created. Waiting is bounded by the `update` timeout, or %[2]s when it is not set.", model.ConnectorStateAlive, defaultConnectorWaitTimeout),
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.ConnectorStateAlive),
+				},
+			},
 			// computed
 			attr.ID: schema.StringAttribute{
 				Computed:    true,
@@ -161,6 +186,9 @@ func (r *connector) Schema(_ context.Context, _ resource.SchemaRequest, resp *re
 				Description: "The Connector's private IP addresses.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			attr.Timeouts: timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -169,6 +197,9 @@ func (r *connector) Create(ctx context.Context, req resource.CreateRequest, resp
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -179,6 +210,8 @@ func (r *connector) Create(ctx context.Context, req resource.CreateRequest, resp
 		StatusUpdatesEnabled: getOptionalBool(plan.StatusUpdatesEnabled),
 	})
 
+	// a new Connector is not waited for, it can't come up before its tokens are generated, which
+	// depends on the Connector being created
 	r.helper(ctx, conn, &plan, &resp.State, &resp.Diagnostics, err, operationCreate)
 }
 
@@ -187,6 +220,9 @@ func (r *connector) Read(ctx context.Context, req resource.ReadRequest, resp *re
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -202,12 +238,28 @@ func (r *connector) Update(ctx context.Context, req resource.UpdateRequest, resp
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// existing Connectors are only waited for when `wait_for_state` is newly set
+	waitForStateChanged := !plan.WaitForState.Equal(state.WaitForState)
+
 	// allowed to change `name` and `status_updates_enabled`
 	if plan.Name == state.Name && plan.StatusUpdatesEnabled == state.StatusUpdatesEnabled {
+		// only deletion_protection changed, nothing to send to the API
+		state.DeletionProtection = plan.DeletionProtection
+		state.WaitForState = plan.WaitForState
+		state.Timeouts = plan.Timeouts
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+		if waitForStateChanged {
+			r.waitForState(ctx, &state, &resp.State, &resp.Diagnostics)
+		}
+
 		return
 	}
 
@@ -224,6 +276,50 @@ func (r *connector) Update(ctx context.Context, req resource.UpdateRequest, resp
 	conn, err := r.client.UpdateConnector(ctx, conn)
 
 	r.helper(ctx, conn, &plan, &resp.State, &resp.Diagnostics, err, operationUpdate)
+
+	if waitForStateChanged {
+		r.waitForState(ctx, &plan, &resp.State, &resp.Diagnostics)
+	}
+}
+
+// waitForState polls the Connector until it reports `wait_for_state`, refreshing the saved state
+// on the way. The Connector is kept in state when it doesn't get there in time.
+func (r *connector) waitForState(ctx context.Context, state *connectorModel, respState *tfsdk.State, diagnostics *diag.Diagnostics) {
+	if diagnostics.HasError() || state.WaitForState.IsNull() || respState.Raw.IsNull() {
+		return
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, defaultConnectorWaitTimeout)
+		defer cancel()
+	}
+
+	expected := state.WaitForState.ValueString()
+
+	var conn *model.Connector
+
+	err := utils.Poll(ctx, connectorStatePollInterval, func(ctx context.Context) (bool, error) {
+		var err error
+
+		conn, err = r.client.ReadConnector(ctx, state.ID.ValueString())
+		if err != nil {
+			return false, err //nolint:wrapcheck
+		}
+
+		return conn.State == expected, nil
+	})
+
+	if conn != nil {
+		r.helper(ctx, conn, state, respState, diagnostics, nil, operationUpdate)
+	}
+
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("%w: expected %s, got %s", ErrConnectorStateNotReached, expected, state.State.ValueString())
+	}
+
+	addErr(diagnostics, err, operationUpdate, TwingateConnector)
 }
 
 func (r *connector) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -231,10 +327,17 @@ func (r *connector) Delete(ctx context.Context, req resource.DeleteRequest, resp
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if isDeletionProtected(state.DeletionProtection, &resp.Diagnostics, TwingateConnector) {
+		return
+	}
+
 	err := r.client.DeleteConnector(ctx, state.ID.ValueString())
 	addErr(&resp.Diagnostics, err, operationDelete, TwingateConnector)
 }
@@ -265,6 +368,7 @@ func (r *connector) helper(ctx context.Context, conn *model.Connector, state *co
 	state.Hostname = types.StringValue(conn.Hostname)
 	state.PublicIP = types.StringValue(conn.PublicIP)
 	state.PrivateIPs = utils.MakeStringSet(conn.PrivateIPs)
+	state.DeletionProtection = deletionProtectionValue(state.DeletionProtection)
 
 	// Set refreshed state
 	diags := respState.Set(ctx, state)
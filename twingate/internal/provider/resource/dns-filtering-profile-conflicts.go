@@ -0,0 +1,135 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// checkPriorityConflicts reports a priority that is already used by another profile, since the precedence
+// between two such profiles, and so for the groups they share, is ambiguous.
+// It only runs when the priority or groups change, so unchanged profiles don't cost an API call.
+func (r *dnsFilteringProfile) checkPriorityConflicts(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	var plan dnsFilteringProfileModel
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		return
+	}
+
+	if plan.Priority.IsUnknown() || plan.Priority.IsNull() {
+		return
+	}
+
+	planned := &model.DNSFilteringProfile{
+		Priority: plan.Priority.ValueFloat64(),
+	}
+
+	if !plan.Groups.IsUnknown() {
+		planned.Groups = convertSetToList(plan.Groups)
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state dnsFilteringProfileModel
+		if diags := req.State.Get(ctx, &state); diags.HasError() {
+			return
+		}
+
+		if state.Priority.Equal(plan.Priority) && state.Groups.Equal(plan.Groups) {
+			return
+		}
+
+		planned.ID = state.ID.ValueString()
+	}
+
+	r.reportPriorityConflicts(ctx, planned, &resp.Diagnostics)
+}
+
+// reportPriorityConflicts compares the profile with the priorities of the list query, and only reads the
+// groups of the profiles that use the same priority. Conflicts are errors or warnings depending on the
+// provider's dns_filtering_profile_priority_conflicts setting.
+func (r *dnsFilteringProfile) reportPriorityConflicts(ctx context.Context, planned *model.DNSFilteringProfile, diagnostics *diag.Diagnostics) {
+	if r.priorityConflicts == providerdata.ConflictsIgnore {
+		return
+	}
+
+	profiles, err := r.client.ReadShallowDNSFilteringProfiles(ctx)
+	if err != nil {
+		if !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+			diagnostics.AddWarning("Couldn't check the DNS filtering profile priority for conflicts", err.Error())
+		}
+
+		return
+	}
+
+	conflicts, _ := findPriorityConflicts(planned, profiles)
+	if len(conflicts) == 0 {
+		return
+	}
+
+	for i, conflict := range conflicts {
+		if profile, err := r.client.ReadDNSFilteringProfile(ctx, conflict.ID); err == nil {
+			conflicts[i] = profile
+		}
+	}
+
+	conflicts, sharedGroups := findPriorityConflicts(planned, conflicts)
+
+	names := utils.Map(conflicts, func(profile *model.DNSFilteringProfile) string {
+		return fmt.Sprintf("%s (%s)", profile.Name, profile.ID)
+	})
+
+	report := diagnostics.AddAttributeError
+	if r.priorityConflicts == providerdata.ConflictsWarn {
+		report = diagnostics.AddAttributeWarning
+	}
+
+	report(path.Root(attr.Priority), "Duplicate DNS filtering profile priority",
+		fmt.Sprintf("Priority %v is already used by: %s. Each profile needs a unique priority.", planned.Priority, strings.Join(names, ", ")))
+
+	if len(sharedGroups) > 0 {
+		report(path.Root(attr.Groups), "Ambiguous DNS filtering profile precedence",
+			fmt.Sprintf("Groups %s are also assigned to a profile with priority %v, so it's undefined which profile applies to them.",
+				strings.Join(sharedGroups, ", "), planned.Priority))
+	}
+}
+
+// findPriorityConflicts returns the other profiles with the planned priority, and the planned groups
+// that are also assigned to one of them.
+func findPriorityConflicts(planned *model.DNSFilteringProfile, profiles []*model.DNSFilteringProfile) ([]*model.DNSFilteringProfile, []string) {
+	var (
+		conflicts    []*model.DNSFilteringProfile
+		sharedGroups []string
+	)
+
+	for _, profile := range profiles {
+		if profile.ID == planned.ID && planned.ID != "" || profile.Priority != planned.Priority {
+			continue
+		}
+
+		conflicts = append(conflicts, profile)
+
+		for _, group := range planned.Groups {
+			if slices.Contains(profile.Groups, group) && !slices.Contains(sharedGroups, group) {
+				sharedGroups = append(sharedGroups, group)
+			}
+		}
+	}
+
+	slices.Sort(sharedGroups)
+
+	return conflicts, sharedGroups
+}
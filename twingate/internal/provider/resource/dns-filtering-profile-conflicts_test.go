@@ -0,0 +1,61 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindPriorityConflicts(t *testing.T) {
+	profiles := []*model.DNSFilteringProfile{
+		{ID: "self", Priority: 1, Groups: []string{"g1"}},
+		{ID: "first", Priority: 2, Groups: []string{"g1", "g2"}},
+		{ID: "second", Priority: 2, Groups: []string{"g3", "g2"}},
+		{ID: "third", Priority: 3, Groups: []string{"g4"}},
+	}
+
+	cases := []struct {
+		name              string
+		planned           *model.DNSFilteringProfile
+		expectedConflicts []string
+		expectedGroups    []string
+	}{
+		{
+			name:    "own priority is ignored on update",
+			planned: &model.DNSFilteringProfile{ID: "self", Priority: 1, Groups: []string{"g1"}},
+		},
+		{
+			name:    "unique priority with shared groups",
+			planned: &model.DNSFilteringProfile{Priority: 1.5, Groups: []string{"g1", "g4"}},
+		},
+		{
+			name:              "duplicate priority without shared groups",
+			planned:           &model.DNSFilteringProfile{Priority: 3, Groups: []string{"g1"}},
+			expectedConflicts: []string{"third"},
+		},
+		{
+			name:              "duplicate priority with shared groups",
+			planned:           &model.DNSFilteringProfile{ID: "self", Priority: 2, Groups: []string{"g3", "g2", "g1", "g5"}},
+			expectedConflicts: []string{"first", "second"},
+			expectedGroups:    []string{"g1", "g2", "g3"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			conflicts, groups := findPriorityConflicts(c.planned, profiles)
+
+			var ids []string
+			if len(conflicts) > 0 {
+				ids = utils.Map(conflicts, func(profile *model.DNSFilteringProfile) string {
+					return profile.ID
+				})
+			}
+
+			assert.Equal(t, c.expectedConflicts, ids)
+			assert.Equal(t, c.expectedGroups, groups)
+		})
+	}
+}
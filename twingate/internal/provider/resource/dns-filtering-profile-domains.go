@@ -0,0 +1,159 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// planDomainSources replaces the domains parsed from `source` with a summary in the plan,
+// so that changing a list with thousands of entries doesn't print every one of them.
+func planDomainSources(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	for _, block := range []string{attr.AllowedDomains, attr.DeniedDomains} {
+		var planned, prior types.Object
+
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root(block), &planned)...)
+
+		if !req.State.Raw.IsNull() {
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(block), &prior)...)
+		}
+
+		if resp.Diagnostics.HasError() || planned.IsNull() || planned.IsUnknown() {
+			continue
+		}
+
+		updated, err := planDomainSource(planned, prior)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(block).AtName(attr.Source), "Invalid Domain List", err.Error())
+
+			continue
+		}
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(block), updated)...)
+	}
+}
+
+// planDomainSource keeps the prior domains when the parsed source matches them, otherwise
+// the domains are left unknown and the summary reports the number of added and removed domains.
+func planDomainSource(planned, prior types.Object) (types.Object, error) {
+	attributes := maps.Clone(planned.Attributes())
+
+	source, _ := attributes[attr.Source].(types.String)
+	format, _ := attributes[attr.SourceFormat].(types.String)
+
+	switch {
+	case source.IsNull():
+		attributes[attr.SourceSummary] = types.StringNull()
+
+	case source.IsUnknown() || format.IsUnknown():
+		attributes[attr.Domains] = types.SetUnknown(types.StringType)
+		attributes[attr.SourceSummary] = types.StringUnknown()
+
+	default:
+		domains, err := utils.ParseDomainList(source.ValueString(), format.ValueString())
+		if err != nil {
+			return planned, err //nolint:wrapcheck
+		}
+
+		priorDomains := priorDomainsOf(prior)
+
+		if !prior.IsNull() && len(setDifference(domains, priorDomains)) == 0 && len(setDifference(priorDomains, domains)) == 0 {
+			priorAttributes := prior.Attributes()
+			attributes[attr.Domains] = priorAttributes[attr.Domains]
+
+			if summary, ok := priorAttributes[attr.SourceSummary].(types.String); ok && !summary.IsNull() && !summary.IsUnknown() {
+				attributes[attr.SourceSummary] = summary
+			} else {
+				attributes[attr.SourceSummary] = types.StringValue(domainSourceSummary(domains, priorDomains))
+			}
+		} else {
+			attributes[attr.Domains] = types.SetUnknown(types.StringType)
+			attributes[attr.SourceSummary] = types.StringValue(domainSourceSummary(domains, priorDomains))
+		}
+	}
+
+	return types.ObjectValueMust(domainsAttributeTypes(), attributes), nil
+}
+
+// resolveDomains returns the domains to apply for the block, parsing `source` when it's set.
+// It also fills in the summary when the source wasn't known at plan time.
+func resolveDomains(block *types.Object, prior types.Object) ([]string, error) {
+	if block.IsNull() || block.IsUnknown() {
+		return []string{}, nil
+	}
+
+	attributes := block.Attributes()
+
+	source, _ := attributes[attr.Source].(types.String)
+	if source.IsNull() {
+		return convertDomains(*block), nil
+	}
+
+	format, _ := attributes[attr.SourceFormat].(types.String)
+
+	domains, err := utils.ParseDomainList(source.ValueString(), format.ValueString())
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if summary, _ := attributes[attr.SourceSummary].(types.String); summary.IsUnknown() {
+		attributes = maps.Clone(attributes)
+		attributes[attr.SourceSummary] = types.StringValue(domainSourceSummary(domains, priorDomainsOf(prior)))
+		*block = types.ObjectValueMust(domainsAttributeTypes(), attributes)
+	}
+
+	return domains, nil
+}
+
+// resolveProfileDomains resolves the allowed and denied domains of the plan, the state is nil on create.
+func resolveProfileDomains(plan, state *dnsFilteringProfileModel) ([]string, []string, error) {
+	priorAllowed, priorDenied := types.ObjectNull(domainsAttributeTypes()), types.ObjectNull(domainsAttributeTypes())
+	if state != nil {
+		priorAllowed, priorDenied = state.AllowedDomains, state.DeniedDomains
+	}
+
+	allowed, err := resolveDomains(&plan.AllowedDomains, priorAllowed)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", attr.AllowedDomains, err)
+	}
+
+	denied, err := resolveDomains(&plan.DeniedDomains, priorDenied)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", attr.DeniedDomains, err)
+	}
+
+	return allowed, denied, nil
+}
+
+func priorDomainsOf(prior types.Object) []string {
+	if prior.IsNull() || prior.IsUnknown() {
+		return nil
+	}
+
+	domains, ok := prior.Attributes()[attr.Domains].(types.Set)
+	if !ok || domains.IsNull() || domains.IsUnknown() {
+		return nil
+	}
+
+	return convertSetToList(domains)
+}
+
+func domainSourceSummary(domains, prior []string) string {
+	return fmt.Sprintf("%d domains, %d added, %d removed",
+		len(domains), len(setDifference(domains, prior)), len(setDifference(prior, domains)))
+}
+
+func nullDomainsSourceAttributes() map[string]tfattr.Value {
+	return map[string]tfattr.Value{
+		attr.Source:        types.StringNull(),
+		attr.SourceFormat:  types.StringNull(),
+		attr.SourceSummary: types.StringNull(),
+	}
+}
@@ -0,0 +1,220 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func newDomainsObject(domains types.Set, source, format, summary types.String) types.Object {
+	return types.ObjectValueMust(domainsAttributeTypes(), map[string]tfattr.Value{
+		attr.IsAuthoritative: types.BoolValue(true),
+		attr.Domains:         domains,
+		attr.Source:          source,
+		attr.SourceFormat:    format,
+		attr.SourceSummary:   summary,
+	})
+}
+
+func TestPlanDomainSource(t *testing.T) {
+	hosts := types.StringValue(utils.DomainListFormatHosts)
+	source := types.StringValue("0.0.0.0 a.example.com b.example.com\n0.0.0.0 c.example.com")
+
+	prior := newDomainsObject(convertStringListToSet([]string{"a.example.com", "b.example.com", "z.example.com"}),
+		types.StringNull(), types.StringNull(), types.StringNull())
+
+	cases := []struct {
+		name            string
+		planned         types.Object
+		prior           types.Object
+		expectedDomains types.Set
+		expectedSummary types.String
+		err             string
+	}{
+		{
+			name:            "without source",
+			planned:         newDomainsObject(convertStringListToSet([]string{"a.example.com"}), types.StringNull(), types.StringNull(), types.StringUnknown()),
+			prior:           prior,
+			expectedDomains: convertStringListToSet([]string{"a.example.com"}),
+			expectedSummary: types.StringNull(),
+		},
+		{
+			name:            "changed source on create",
+			planned:         newDomainsObject(defaultEmptySet(), source, hosts, types.StringUnknown()),
+			prior:           types.ObjectNull(domainsAttributeTypes()),
+			expectedDomains: types.SetUnknown(types.StringType),
+			expectedSummary: types.StringValue("3 domains, 3 added, 0 removed"),
+		},
+		{
+			name:            "changed source on update",
+			planned:         newDomainsObject(defaultEmptySet(), source, hosts, types.StringUnknown()),
+			prior:           prior,
+			expectedDomains: types.SetUnknown(types.StringType),
+			expectedSummary: types.StringValue("3 domains, 1 added, 1 removed"),
+		},
+		{
+			name:    "unchanged source keeps the prior domains and summary",
+			planned: newDomainsObject(defaultEmptySet(), types.StringValue("b.example.com\nA.example.com"), types.StringNull(), types.StringUnknown()),
+			prior: newDomainsObject(convertStringListToSet([]string{"a.example.com", "b.example.com"}),
+				types.StringValue("a.example.com\nb.example.com"), types.StringNull(), types.StringValue("2 domains, 2 added, 0 removed")),
+			expectedDomains: convertStringListToSet([]string{"a.example.com", "b.example.com"}),
+			expectedSummary: types.StringValue("2 domains, 2 added, 0 removed"),
+		},
+		{
+			name:            "unknown source",
+			planned:         newDomainsObject(defaultEmptySet(), types.StringUnknown(), types.StringNull(), types.StringUnknown()),
+			prior:           prior,
+			expectedDomains: types.SetUnknown(types.StringType),
+			expectedSummary: types.StringUnknown(),
+		},
+		{
+			name:    "invalid source",
+			planned: newDomainsObject(defaultEmptySet(), types.StringValue("not a domain"), types.StringNull(), types.StringUnknown()),
+			prior:   prior,
+			err:     `invalid domain list: line 1: expected a single domain, got "not a domain"`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := planDomainSource(c.planned, c.prior)
+
+			if c.err != "" {
+				assert.EqualError(t, err, c.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, c.expectedDomains, actual.Attributes()[attr.Domains])
+			assert.Equal(t, c.expectedSummary, actual.Attributes()[attr.SourceSummary])
+		})
+	}
+}
+
+func TestResolveDomains(t *testing.T) {
+	prior := newDomainsObject(convertStringListToSet([]string{"a.example.com"}), types.StringNull(), types.StringNull(), types.StringNull())
+
+	t.Run("plain domains", func(t *testing.T) {
+		block := newDomainsObject(convertStringListToSet([]string{"b.example.com"}), types.StringNull(), types.StringNull(), types.StringNull())
+
+		domains, err := resolveDomains(&block, prior)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"b.example.com"}, domains)
+	})
+
+	t.Run("source with unknown summary", func(t *testing.T) {
+		block := newDomainsObject(types.SetUnknown(types.StringType), types.StringValue("||a.example.com^\n||b.example.com^"),
+			types.StringValue(utils.DomainListFormatAdblock), types.StringUnknown())
+
+		domains, err := resolveDomains(&block, prior)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a.example.com", "b.example.com"}, domains)
+		assert.Equal(t, types.StringValue("2 domains, 1 added, 0 removed"), block.Attributes()[attr.SourceSummary])
+	})
+
+	t.Run("null block", func(t *testing.T) {
+		block := types.ObjectNull(domainsAttributeTypes())
+
+		domains, err := resolveDomains(&block, prior)
+
+		assert.NoError(t, err)
+		assert.Empty(t, domains)
+	})
+}
+
+func TestConvertDomainsToTerraformKeepsSourceSettings(t *testing.T) {
+	prior := newDomainsObject(types.SetUnknown(types.StringType), types.StringValue("a.example.com"),
+		types.StringValue(utils.DomainListFormatPlain), types.StringValue("1 domains, 1 added, 0 removed"))
+
+	actual := convertDomainsToTerraform([]string{"a.example.com"}, prior)
+
+	assert.Equal(t, newDomainsObject(convertStringListToSet([]string{"a.example.com"}), types.StringValue("a.example.com"),
+		types.StringValue(utils.DomainListFormatPlain), types.StringValue("1 domains, 1 added, 0 removed")), actual)
+
+	imported := convertDomainsToTerraform([]string{"a.example.com"}, types.ObjectNull(domainsAttributeTypes()))
+
+	assert.Equal(t, newDomainsObject(convertStringListToSet([]string{"a.example.com"}),
+		types.StringNull(), types.StringNull(), types.StringNull()), imported)
+}
+
+func TestDNSFilteringProfileDomainSourcesWithSchema(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := resource.SchemaResponse{}
+	NewDNSFilteringProfile().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	assert.False(t, schemaResp.Diagnostics.HasError())
+
+	profileSchema := schemaResp.Schema
+
+	planned := dnsFilteringProfileModel{
+		ID:             types.StringValue("profile-id"),
+		Name:           types.StringValue("profile"),
+		Priority:       types.Float64Value(1),
+		FallbackMethod: types.StringValue("STRICT"),
+		Groups:         defaultEmptySet(),
+		AllowedDomains: newDomainsObject(defaultEmptySet(), types.StringValue("||a.example.com^\n||b.example.com^"),
+			types.StringValue(utils.DomainListFormatAdblock), types.StringUnknown()),
+		DeniedDomains:      types.ObjectNull(domainsAttributeTypes()),
+		ContentCategories:  types.ObjectNull(contentCategoriesAttributeTypes()),
+		SecurityCategories: types.ObjectNull(securityCategoriesAttributeTypes()),
+		PrivacyCategories:  types.ObjectNull(privacyCategoriesAttributeTypes()),
+		Timeouts:           nullTimeouts(ctx),
+	}
+
+	// plan
+	plan := tfsdk.Plan{Schema: profileSchema}
+	assert.False(t, plan.Set(ctx, &planned).HasError())
+
+	req := resource.ModifyPlanRequest{
+		Plan:  plan,
+		State: tfsdk.State{Schema: profileSchema, Raw: tftypes.NewValue(profileSchema.Type().TerraformType(ctx), nil)},
+	}
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+
+	(&dnsFilteringProfile{}).ModifyPlan(ctx, req, resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var summary types.String
+	resp.Plan.GetAttribute(ctx, path.Root(attr.AllowedDomains).AtName(attr.SourceSummary), &summary)
+	assert.Equal(t, "2 domains, 2 added, 0 removed", summary.ValueString())
+
+	// apply
+	var applied dnsFilteringProfileModel
+	assert.False(t, resp.Plan.Get(ctx, &applied).HasError())
+
+	profile := &model.DNSFilteringProfile{
+		ID:             "profile-id",
+		Name:           "profile",
+		Priority:       1,
+		FallbackMethod: "STRICT",
+		AllowedDomains: []string{"a.example.com", "b.example.com"},
+	}
+
+	state := tfsdk.State{Schema: profileSchema, Raw: tftypes.NewValue(profileSchema.Type().TerraformType(ctx), nil)}
+
+	var diags diag.Diagnostics
+
+	(&dnsFilteringProfile{}).helper(ctx, profile, &applied, &state, &diags, nil, operationCreate)
+	assert.False(t, diags.HasError(), diags)
+
+	var domains types.Set
+	state.GetAttribute(ctx, path.Root(attr.AllowedDomains).AtName(attr.Domains), &domains)
+	state.GetAttribute(ctx, path.Root(attr.AllowedDomains).AtName(attr.SourceSummary), &summary)
+
+	assert.Equal(t, convertStringListToSet([]string{"a.example.com", "b.example.com"}), domains)
+	assert.Equal(t, "2 domains, 2 added, 0 removed", summary.ValueString())
+}
@@ -3,12 +3,14 @@ package resource
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -28,26 +30,29 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.Resource = &dnsFilteringProfile{}
 var _ resource.ResourceWithImportState = &dnsFilteringProfile{}
+var _ resource.ResourceWithModifyPlan = &dnsFilteringProfile{}
 
 func NewDNSFilteringProfile() resource.Resource {
 	return &dnsFilteringProfile{}
 }
 
 type dnsFilteringProfile struct {
-	client *client.Client
+	client            *client.Client
+	priorityConflicts string
 }
 
 type dnsFilteringProfileModel struct {
-	ID                 types.String  `tfsdk:"id"`
-	Name               types.String  `tfsdk:"name"`
-	Priority           types.Float64 `tfsdk:"priority"`
-	FallbackMethod     types.String  `tfsdk:"fallback_method"`
-	Groups             types.Set     `tfsdk:"groups"`
-	AllowedDomains     types.Object  `tfsdk:"allowed_domains"`
-	DeniedDomains      types.Object  `tfsdk:"denied_domains"`
-	ContentCategories  types.Object  `tfsdk:"content_categories"`
-	SecurityCategories types.Object  `tfsdk:"security_categories"`
-	PrivacyCategories  types.Object  `tfsdk:"privacy_categories"`
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Priority           types.Float64  `tfsdk:"priority"`
+	FallbackMethod     types.String   `tfsdk:"fallback_method"`
+	Groups             types.Set      `tfsdk:"groups"`
+	AllowedDomains     types.Object   `tfsdk:"allowed_domains"`
+	DeniedDomains      types.Object   `tfsdk:"denied_domains"`
+	ContentCategories  types.Object   `tfsdk:"content_categories"`
+	SecurityCategories types.Object   `tfsdk:"security_categories"`
+	PrivacyCategories  types.Object   `tfsdk:"privacy_categories"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *dnsFilteringProfile) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -65,6 +70,7 @@ func (r *dnsFilteringProfile) Configure(_ context.Context, req resource.Configur
 	}
 
 	r.client = providerData.Client
+	r.priorityConflicts = providerData.Config.DNSFilteringProfilePriorityConflicts
 }
 
 func (r *dnsFilteringProfile) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -81,11 +87,11 @@ func (r *dnsFilteringProfile) ImportState(ctx context.Context, req resource.Impo
 	resp.State.SetAttribute(ctx, path.Root(attr.Groups), convertStringListToSet(profile.Groups))
 
 	if len(profile.AllowedDomains) > 0 {
-		resp.State.SetAttribute(ctx, path.Root(attr.AllowedDomains), convertDomainsToTerraform(profile.AllowedDomains, nil))
+		resp.State.SetAttribute(ctx, path.Root(attr.AllowedDomains), convertDomainsToTerraform(profile.AllowedDomains, types.ObjectNull(domainsAttributeTypes())))
 	}
 
 	if len(profile.DeniedDomains) > 0 {
-		resp.State.SetAttribute(ctx, path.Root(attr.DeniedDomains), convertDomainsToTerraform(profile.DeniedDomains, nil))
+		resp.State.SetAttribute(ctx, path.Root(attr.DeniedDomains), convertDomainsToTerraform(profile.DeniedDomains, types.ObjectNull(domainsAttributeTypes())))
 	}
 
 	if profile.ContentCategories != nil {
@@ -101,7 +107,7 @@ func (r *dnsFilteringProfile) ImportState(ctx context.Context, req resource.Impo
 	}
 }
 
-func (r *dnsFilteringProfile) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) { //nolint
+func (r *dnsFilteringProfile) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) { //nolint
 	resp.Schema = schema.Schema{
 		Description: "DNS filtering gives you the ability to control what websites your users can access. DNS filtering is only available on certain plans. For more information, see Twingate's [documentation](https://www.twingate.com/docs/dns-filtering). DNS filtering must be enabled for this resources to work. If DNS filtering isn't enabled, the provider will throw an error.",
 		Attributes: map[string]schema.Attribute{
@@ -111,7 +117,7 @@ func (r *dnsFilteringProfile) Schema(_ context.Context, _ resource.SchemaRequest
 			},
 			attr.Priority: schema.Float64Attribute{
 				Required:    true,
-				Description: "A floating point number representing the profile's priority.",
+				Description: "A floating point number representing the profile's priority. It must be unique across the account's DNS filtering profiles, which is checked against the existing profiles at plan time, and again before creating the profile to catch another new profile with the same priority. See the provider's `dns_filtering_profile_priority_conflicts` setting.",
 			},
 			// optional
 			attr.FallbackMethod: schema.StringAttribute{
@@ -142,43 +148,9 @@ func (r *dnsFilteringProfile) Schema(_ context.Context, _ resource.SchemaRequest
 		},
 
 		Blocks: map[string]schema.Block{
-			attr.AllowedDomains: schema.SingleNestedBlock{
-				Description: "A block with the following attributes.",
-				Attributes: map[string]schema.Attribute{
-					attr.IsAuthoritative: schema.BoolAttribute{
-						Optional:    true,
-						Computed:    true,
-						Description: "Whether Terraform should override changes made outside of Terraform. Defaults to true.",
-						Default:     booldefault.StaticBool(true),
-					},
-					attr.Domains: schema.SetAttribute{
-						Optional:    true,
-						Computed:    true,
-						ElementType: types.StringType,
-						Description: "A set of allowed domains. Defaults to an empty set.",
-						Default:     setdefault.StaticValue(defaultEmptySet()),
-					},
-				},
-			},
-
-			attr.DeniedDomains: schema.SingleNestedBlock{
-				Description: "A block with the following attributes.",
-				Attributes: map[string]schema.Attribute{
-					attr.IsAuthoritative: schema.BoolAttribute{
-						Optional:    true,
-						Computed:    true,
-						Description: "Whether Terraform should override changes made outside of Terraform. Defaults to true.",
-						Default:     booldefault.StaticBool(true),
-					},
-					attr.Domains: schema.SetAttribute{
-						Optional:    true,
-						Computed:    true,
-						ElementType: types.StringType,
-						Description: "A set of denied domains. Defaults to an empty set.",
-						Default:     setdefault.StaticValue(defaultEmptySet()),
-					},
-				},
-			},
+			attr.Timeouts:       timeoutsBlock(ctx),
+			attr.AllowedDomains: domainsBlock("allowed"),
+			attr.DeniedDomains:  domainsBlock("denied"),
 
 			//nolint:dupl
 			attr.ContentCategories: schema.SingleNestedBlock{
@@ -329,6 +301,56 @@ func (r *dnsFilteringProfile) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (r *dnsFilteringProfile) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip during destroy plans.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	planDomainSources(ctx, req, resp)
+	r.checkPriorityConflicts(ctx, req, resp)
+}
+
+func domainsBlock(kind string) schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: "A block with the following attributes.",
+		Attributes: map[string]schema.Attribute{
+			attr.IsAuthoritative: schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether Terraform should override changes made outside of Terraform. Defaults to true.",
+				Default:     booldefault.StaticBool(true),
+			},
+			attr.Domains: schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: fmt.Sprintf("A set of %s domains. Defaults to an empty set. When `source` is set, it's populated from the parsed list.", kind),
+				Default:     setdefault.StaticValue(defaultEmptySet()),
+			},
+			attr.Source: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The content of a list of %s domains, e.g. the output of `file()`, in the `source_format` format. Domains are converted to punycode, stripped of leading wildcards, validated and deduplicated. Instead of every changed domain, the plan shows `source_summary`.", kind),
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName(attr.Domains)),
+				},
+			},
+			attr.SourceFormat: schema.StringAttribute{
+				Optional:    true,
+				Description: "The format of `source`: `plain` (one domain per line), `hosts` (hosts-file lines like `0.0.0.0 example.com`) or `adblock` (`||example.com^` rules). Defaults to `plain`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.DomainListFormats...),
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName(attr.Source)),
+				},
+			},
+			attr.SourceSummary: schema.StringAttribute{
+				Computed:    true,
+				Description: "The number of domains parsed from `source`, and how many were added and removed by the last change.",
+			},
+		},
+	}
+}
+
 func defaultEmptySet() types.Set {
 	return types.SetValueMust(types.StringType, []tfattr.Value{})
 }
@@ -338,6 +360,26 @@ func (r *dnsFilteringProfile) Create(ctx context.Context, req resource.CreateReq
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allowedDomains, deniedDomains, err := resolveProfileDomains(&plan, nil)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, TwingateDNSFilteringProfile)
+
+		return
+	}
+
+	// catches another profile with the same priority created earlier in the same apply
+	r.reportPriorityConflicts(ctx, &model.DNSFilteringProfile{
+		Priority: plan.Priority.ValueFloat64(),
+		Groups:   convertSetToList(plan.Groups),
+	}, &resp.Diagnostics)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -351,8 +393,8 @@ func (r *dnsFilteringProfile) Create(ctx context.Context, req resource.CreateReq
 
 		profile.FallbackMethod = plan.FallbackMethod.ValueString()
 		profile.Groups = convertSetToList(plan.Groups)
-		profile.AllowedDomains = convertDomains(plan.AllowedDomains)
-		profile.DeniedDomains = convertDomains(plan.DeniedDomains)
+		profile.AllowedDomains = allowedDomains
+		profile.DeniedDomains = deniedDomains
 		profile.PrivacyCategories = convertPrivacyCategories(plan.PrivacyCategories)
 		profile.ContentCategories = convertContentCategories(plan.ContentCategories)
 		profile.SecurityCategories = convertSecurityCategories(plan.SecurityCategories)
@@ -368,6 +410,9 @@ func (r *dnsFilteringProfile) Read(ctx context.Context, req resource.ReadRequest
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -393,12 +438,19 @@ func (r *dnsFilteringProfile) Update(ctx context.Context, req resource.UpdateReq
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	allowedDomains := convertDomains(plan.AllowedDomains)
-	deniedDomains := convertDomains(plan.DeniedDomains)
+	allowedDomains, deniedDomains, err := resolveProfileDomains(&plan, &state)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationUpdate, TwingateDNSFilteringProfile)
+
+		return
+	}
 
 	profile := &model.DNSFilteringProfile{
 		ID:                 state.ID.ValueString(),
@@ -441,8 +493,6 @@ func (r *dnsFilteringProfile) Update(ctx context.Context, req resource.UpdateReq
 		profile.DeniedDomains = setUnion(profile.DeniedDomains, originDeniedDomains)
 	}
 
-	var err error
-
 	profile, err = r.client.UpdateDNSFilteringProfile(ctx, profile)
 
 	if profile != nil {
@@ -463,6 +513,9 @@ func (r *dnsFilteringProfile) Delete(ctx context.Context, req resource.DeleteReq
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -492,11 +545,11 @@ func (r *dnsFilteringProfile) helper(ctx context.Context, profile *model.DNSFilt
 	state.Groups = convertStringListToSet(profile.Groups)
 
 	if !state.AllowedDomains.IsNull() {
-		state.AllowedDomains = convertDomainsToTerraform(profile.AllowedDomains, state.AllowedDomains.Attributes()[attr.IsAuthoritative])
+		state.AllowedDomains = convertDomainsToTerraform(profile.AllowedDomains, state.AllowedDomains)
 	}
 
 	if !state.DeniedDomains.IsNull() {
-		state.DeniedDomains = convertDomainsToTerraform(profile.DeniedDomains, state.DeniedDomains.Attributes()[attr.IsAuthoritative])
+		state.DeniedDomains = convertDomainsToTerraform(profile.DeniedDomains, state.DeniedDomains)
 	}
 
 	if !state.ContentCategories.IsNull() && profile.ContentCategories != nil {
@@ -594,17 +647,19 @@ func privacyCategoriesAttributeTypes() map[string]tfattr.Type {
 	}
 }
 
-func convertDomainsToTerraform(domains []string, isAuthoritative tfattr.Value) types.Object {
-	authoritative := types.BoolValue(true)
-	if isAuthoritative != nil {
-		authoritative = isAuthoritative.(types.Bool)
-	}
+// convertDomainsToTerraform keeps the prior block's settings, which the API doesn't store, alongside the domains.
+func convertDomainsToTerraform(domains []string, prior types.Object) types.Object {
+	attributes := nullDomainsSourceAttributes()
+	attributes[attr.IsAuthoritative] = types.BoolValue(true)
 
-	attributes := map[string]tfattr.Value{
-		attr.IsAuthoritative: authoritative,
-		attr.Domains:         convertStringListToSet(domains),
+	if !prior.IsNull() && !prior.IsUnknown() {
+		for name, value := range prior.Attributes() {
+			attributes[name] = value
+		}
 	}
 
+	attributes[attr.Domains] = convertStringListToSet(domains)
+
 	return types.ObjectValueMust(domainsAttributeTypes(), attributes)
 }
 
@@ -614,6 +669,9 @@ func domainsAttributeTypes() map[string]tfattr.Type {
 		attr.Domains: types.SetType{
 			ElemType: types.StringType,
 		},
+		attr.Source:        types.StringType,
+		attr.SourceFormat:  types.StringType,
+		attr.SourceSummary: types.StringType,
 	}
 }
 
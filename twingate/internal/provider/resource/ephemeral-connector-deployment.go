@@ -0,0 +1,323 @@
+package resource
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	connectorDeploymentFormatDockerCompose = "docker-compose"
+	connectorDeploymentFormatSystemd       = "systemd"
+	connectorDeploymentFormatKubernetes    = "kubernetes"
+	connectorDeploymentFormatHelm          = "helm"
+
+	defaultConnectorDeploymentName  = "twingate-connector"
+	defaultConnectorDeploymentImage = "twingate/connector:1"
+
+	connectorDeploymentEnvDir = "/etc/twingate/"
+)
+
+var (
+	ErrUnsupportedConnectorDeploymentFormat = errors.New("unsupported connector deployment format")
+	ErrConnectorDeploymentTokensRequired    = errors.New("access_token and refresh_token are required unless generate_tokens is true")
+)
+
+//go:embed connector-deployment-*.tmpl.*
+var connectorDeploymentTemplates embed.FS
+
+var connectorDeploymentNameRgx = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// connectorDeploymentFile maps a rendered file name to the template it is rendered from.
+type connectorDeploymentFile struct {
+	name     func(data connectorDeploymentData) string
+	template string
+}
+
+func staticFileName(name string) func(data connectorDeploymentData) string {
+	return func(_ connectorDeploymentData) string {
+		return name
+	}
+}
+
+//nolint:gochecknoglobals
+var connectorDeploymentFormats = map[string][]connectorDeploymentFile{
+	connectorDeploymentFormatDockerCompose: {
+		{name: staticFileName("docker-compose.yaml"), template: "connector-deployment-docker-compose.tmpl.yaml"},
+	},
+	connectorDeploymentFormatSystemd: {
+		{name: func(data connectorDeploymentData) string { return data.Name + ".service" }, template: "connector-deployment-systemd.tmpl.service"},
+		{name: func(data connectorDeploymentData) string { return data.Name + ".conf" }, template: "connector-deployment-systemd.tmpl.conf"},
+	},
+	connectorDeploymentFormatKubernetes: {
+		{name: staticFileName("secret.yaml"), template: "connector-deployment-kubernetes-secret.tmpl.yaml"},
+		{name: staticFileName("deployment.yaml"), template: "connector-deployment-kubernetes-deployment.tmpl.yaml"},
+	},
+	connectorDeploymentFormatHelm: {
+		{name: staticFileName("values.yaml"), template: "connector-deployment-helm.tmpl.yaml"},
+	},
+}
+
+type connectorDeploymentData struct {
+	Name         string
+	Namespace    string
+	Image        string
+	Network      string
+	Host         string
+	AccessToken  string
+	RefreshToken string
+}
+
+// URL is the address of the Twingate network the Connector connects to.
+func (d connectorDeploymentData) URL() string {
+	return fmt.Sprintf("https://%s.%s", d.Network, d.Host)
+}
+
+// EnvFile is where the systemd unit expects its environment file.
+func (d connectorDeploymentData) EnvFile() string {
+	return connectorDeploymentEnvDir + d.Name + ".conf"
+}
+
+func (d connectorDeploymentData) ImageRepository() string {
+	repository, _ := splitImage(d.Image)
+
+	return repository
+}
+
+func (d connectorDeploymentData) ImageTag() string {
+	_, tag := splitImage(d.Image)
+
+	return tag
+}
+
+// splitImage splits `repository:tag`, ignoring a registry port like `registry:5000/connector`.
+func splitImage(image string) (string, string) {
+	idx := strings.LastIndex(image, ":")
+	if idx == -1 || strings.Contains(image[idx:], "/") {
+		return image, ""
+	}
+
+	return image[:idx], image[idx+1:]
+}
+
+// renderConnectorDeployment renders the files of the given format, keyed by file name.
+func renderConnectorDeployment(format string, data connectorDeploymentData) (map[string]string, error) {
+	files, ok := connectorDeploymentFormats[format]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedConnectorDeploymentFormat, format)
+	}
+
+	tmpl, err := template.New(format).Funcs(template.FuncMap{"quote": strconv.Quote}).ParseFS(connectorDeploymentTemplates, "connector-deployment-*.tmpl.*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse connector deployment templates: %w", err)
+	}
+
+	rendered := make(map[string]string, len(files))
+
+	for _, file := range files {
+		var buf bytes.Buffer
+		if err := tmpl.ExecuteTemplate(&buf, file.template, data); err != nil {
+			return nil, fmt.Errorf("failed to render %s: %w", file.template, err)
+		}
+
+		rendered[file.name(data)] = buf.String()
+	}
+
+	return rendered, nil
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ ephemeral.EphemeralResource = &ephemeralConnectorDeployment{}
+
+func NewEphemeralConnectorDeployment() ephemeral.EphemeralResourceWithConfigure {
+	return &ephemeralConnectorDeployment{}
+}
+
+type ephemeralConnectorDeployment struct {
+	client         *client.Client
+	providerConfig providerdata.Config
+}
+
+type ephemeralConnectorDeploymentModel struct {
+	ConnectorID    types.String `tfsdk:"connector_id"`
+	Format         types.String `tfsdk:"format"`
+	Name           types.String `tfsdk:"name"`
+	Namespace      types.String `tfsdk:"namespace"`
+	Image          types.String `tfsdk:"image"`
+	AccessToken    types.String `tfsdk:"access_token"`
+	RefreshToken   types.String `tfsdk:"refresh_token"`
+	GenerateTokens types.Bool   `tfsdk:"generate_tokens"`
+	Files          types.Map    `tfsdk:"files"`
+}
+
+func (r *ephemeralConnectorDeployment) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = TwingateConnectorDeployment
+}
+
+func (r *ephemeralConnectorDeployment) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerConfig = providerData.Config
+}
+
+func (r *ephemeralConnectorDeployment) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Renders the files needed to deploy a Connector with the given tokens. The tokens are only part of the rendered files and are never written to state by this ephemeral resource.",
+		MarkdownDescription: "Renders the files needed to deploy a Connector with the given tokens. The tokens are only part of the rendered files and are never written to state by this ephemeral resource.\n\n~> **Warning:** Ephemeral resources are opened on every plan and apply. With `generate_tokens`, each of them, including `terraform plan`, generates new tokens and invalidates the ones the running Connector uses, taking it offline until the rendered files are redeployed.",
+		Attributes: map[string]schema.Attribute{
+			attr.ConnectorID: schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Connector to deploy.",
+			},
+			attr.Format: schema.StringAttribute{
+				Required: true,
+				Description: "The deployment format, one of `docker-compose` (`docker-compose.yaml`), `systemd` (`<name>.service` and its environment file `<name>.conf`, " +
+					"to be placed in `/etc/twingate/`), `kubernetes` (`secret.yaml` and `deployment.yaml`) or `helm` (`values.yaml` for the `twingate/connector` chart).",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						connectorDeploymentFormatDockerCompose,
+						connectorDeploymentFormatSystemd,
+						connectorDeploymentFormatKubernetes,
+						connectorDeploymentFormatHelm,
+					),
+				},
+			},
+			attr.Name: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Name of the container, systemd unit or Kubernetes objects. Defaults to `%s`.", defaultConnectorDeploymentName),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(connectorDeploymentNameRgx, "must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character"),
+				},
+			},
+			attr.Namespace: schema.StringAttribute{
+				Optional:    true,
+				Description: "Kubernetes namespace of the rendered objects. When not set, the namespace is left to `kubectl`.",
+			},
+			attr.Image: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Connector image. Defaults to `%s`.", defaultConnectorDeploymentImage),
+			},
+			attr.AccessToken: schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The Access Token of the Connector, e.g. from `twingate_connector_tokens`.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot(attr.RefreshToken)),
+					stringvalidator.ExactlyOneOf(path.MatchRoot(attr.GenerateTokens)),
+				},
+			},
+			attr.RefreshToken: schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The Refresh Token of the Connector, e.g. from `twingate_connector_tokens`.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot(attr.AccessToken)),
+				},
+			},
+			attr.GenerateTokens: schema.BoolAttribute{
+				Optional: true,
+				Description: "Generate new tokens for the Connector instead of taking `access_token` and `refresh_token`. " +
+					"This invalidates the tokens of the running Connector on every plan and apply, so it's only meant for Connectors that are redeployed with the rendered files each time.",
+			},
+			// computed
+			attr.Files: schema.MapAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "The rendered files, keyed by file name.",
+			},
+		},
+	}
+}
+
+func (r *ephemeralConnectorDeployment) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Client not configured",
+			"The provider client is nil. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var config ephemeralConnectorDeploymentModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokens := &model.ConnectorTokens{
+		AccessToken:  config.AccessToken.ValueString(),
+		RefreshToken: config.RefreshToken.ValueString(),
+	}
+
+	switch {
+	case config.GenerateTokens.ValueBool():
+		generated, err := r.client.GenerateConnectorTokens(ctx, config.ConnectorID.ValueString())
+		if err != nil {
+			addErr(&resp.Diagnostics, err, operationCreate, TwingateConnectorDeployment)
+
+			return
+		}
+
+		tokens = generated
+	case config.AccessToken.IsNull():
+		addErr(&resp.Diagnostics, ErrConnectorDeploymentTokensRequired, operationCreate, TwingateConnectorDeployment)
+
+		return
+	}
+
+	data := connectorDeploymentData{
+		Name:         withDefaultValue(config.Name.ValueString(), defaultConnectorDeploymentName),
+		Namespace:    config.Namespace.ValueString(),
+		Image:        withDefaultValue(config.Image.ValueString(), defaultConnectorDeploymentImage),
+		Network:      r.providerConfig.Network,
+		Host:         r.providerConfig.URL,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}
+
+	files, err := renderConnectorDeployment(config.Format.ValueString(), data)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, TwingateConnectorDeployment)
+
+		return
+	}
+
+	config.Files = utils.ConvertMapValue(files)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, config)...)
+}
@@ -0,0 +1,170 @@
+package resource
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files")
+
+func testConnectorDeploymentData() connectorDeploymentData {
+	return connectorDeploymentData{
+		Name:         "aws-connector",
+		Namespace:    "twingate",
+		Image:        defaultConnectorDeploymentImage,
+		Network:      "autoco",
+		Host:         "twingate.com",
+		AccessToken:  "access-token",
+		RefreshToken: "refresh-token",
+	}
+}
+
+func TestRenderConnectorDeploymentGolden(t *testing.T) {
+	for format := range connectorDeploymentFormats {
+		t.Run(format, func(t *testing.T) {
+			files, err := renderConnectorDeployment(format, testConnectorDeploymentData())
+			assert.NoError(t, err)
+
+			goldenDir := filepath.Join("testdata", "connector-deployment", format)
+
+			if *updateGolden {
+				assert.NoError(t, os.RemoveAll(goldenDir))
+				assert.NoError(t, os.MkdirAll(goldenDir, 0o755))
+
+				for name, content := range files {
+					assert.NoError(t, os.WriteFile(filepath.Join(goldenDir, name+".golden"), []byte(content), 0o600))
+				}
+			}
+
+			goldenFiles, err := filepath.Glob(filepath.Join(goldenDir, "*.golden"))
+			assert.NoError(t, err)
+			assert.Len(t, files, len(goldenFiles))
+
+			for name, content := range files {
+				expected, err := os.ReadFile(filepath.Join(goldenDir, name+".golden"))
+				assert.NoError(t, err)
+				assert.Equal(t, string(expected), content, name)
+			}
+		})
+	}
+}
+
+func TestRenderConnectorDeploymentWithoutNamespace(t *testing.T) {
+	data := testConnectorDeploymentData()
+	data.Namespace = ""
+
+	files, err := renderConnectorDeployment(connectorDeploymentFormatKubernetes, data)
+
+	assert.NoError(t, err)
+	assert.NotContains(t, files["secret.yaml"], "namespace:")
+	assert.NotContains(t, files["deployment.yaml"], "namespace:")
+}
+
+func TestRenderConnectorDeploymentUnsupportedFormat(t *testing.T) {
+	_, err := renderConnectorDeployment("nomad", testConnectorDeploymentData())
+
+	assert.ErrorIs(t, err, ErrUnsupportedConnectorDeploymentFormat)
+}
+
+func TestSplitImage(t *testing.T) {
+	cases := []struct {
+		image              string
+		expectedRepository string
+		expectedTag        string
+	}{
+		{image: "twingate/connector:1", expectedRepository: "twingate/connector", expectedTag: "1"},
+		{image: "twingate/connector", expectedRepository: "twingate/connector"},
+		{image: "registry:5000/twingate/connector", expectedRepository: "registry:5000/twingate/connector"},
+		{image: "registry:5000/twingate/connector:1.62.0", expectedRepository: "registry:5000/twingate/connector", expectedTag: "1.62.0"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.image, func(t *testing.T) {
+			repository, tag := splitImage(c.image)
+
+			assert.Equal(t, c.expectedRepository, repository)
+			assert.Equal(t, c.expectedTag, tag)
+		})
+	}
+}
+
+func TestEphemeralConnectorDeploymentOpenUsesGivenTokens(t *testing.T) {
+	ctx := context.Background()
+
+	// no responders: generating tokens would fail the test
+	apiClient := client.NewClient(t.Context(), "https://test.twindev.com", "xxxx", time.Second, 0, client.DefaultAgent, "test", client.CacheOptions{})
+	httpmock.ActivateNonDefault(apiClient.HTTPClient)
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	resource := &ephemeralConnectorDeployment{client: apiClient, providerConfig: providerdata.Config{Network: "autoco", URL: "twingate.com"}}
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	resource.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+	assert.False(t, schemaResp.Diagnostics.HasError())
+
+	deploymentSchema := schemaResp.Schema
+
+	cases := []struct {
+		name           string
+		accessToken    types.String
+		refreshToken   types.String
+		generateTokens types.Bool
+		expectedError  bool
+	}{
+		{name: "given tokens", accessToken: types.StringValue("access-token"), refreshToken: types.StringValue("refresh-token"), generateTokens: types.BoolNull()},
+		{name: "no tokens", accessToken: types.StringNull(), refreshToken: types.StringNull(), generateTokens: types.BoolValue(false), expectedError: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			config := tfsdk.Config{
+				Schema: deploymentSchema,
+				Raw: tftypes.NewValue(deploymentSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+					"connector_id":    tftypes.NewValue(tftypes.String, "connector-id"),
+					"format":          tftypes.NewValue(tftypes.String, connectorDeploymentFormatDockerCompose),
+					"name":            tftypes.NewValue(tftypes.String, nil),
+					"namespace":       tftypes.NewValue(tftypes.String, nil),
+					"image":           tftypes.NewValue(tftypes.String, nil),
+					"access_token":    tftypes.NewValue(tftypes.String, c.accessToken.ValueStringPointer()),
+					"refresh_token":   tftypes.NewValue(tftypes.String, c.refreshToken.ValueStringPointer()),
+					"generate_tokens": tftypes.NewValue(tftypes.Bool, c.generateTokens.ValueBoolPointer()),
+					"files":           tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				}),
+			}
+
+			resp := &ephemeral.OpenResponse{
+				Result: tfsdk.EphemeralResultData{Schema: deploymentSchema, Raw: tftypes.NewValue(deploymentSchema.Type().TerraformType(ctx), nil)},
+			}
+
+			resource.Open(ctx, ephemeral.OpenRequest{Config: config}, resp)
+
+			assert.Equal(t, c.expectedError, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Zero(t, httpmock.GetTotalCallCount())
+
+			if c.expectedError {
+				return
+			}
+
+			var result ephemeralConnectorDeploymentModel
+			assert.False(t, resp.Result.Get(ctx, &result).HasError())
+
+			files := map[string]string{}
+			assert.False(t, result.Files.ElementsAs(ctx, &files, false).HasError())
+			assert.Contains(t, files["docker-compose.yaml"], "access-token")
+			assert.Contains(t, files["docker-compose.yaml"], "refresh-token")
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ ephemeral.EphemeralResource = &ephemeralConnectorTokensVerification{}
+
+func NewEphemeralConnectorTokensVerification() ephemeral.EphemeralResourceWithConfigure {
+	return &ephemeralConnectorTokensVerification{}
+}
+
+type ephemeralConnectorTokensVerification struct {
+	client *client.Client
+}
+
+type ephemeralConnectorTokensVerificationModel struct {
+	AccessToken  types.String `tfsdk:"access_token"`
+	RefreshToken types.String `tfsdk:"refresh_token"`
+	RequireValid types.Bool   `tfsdk:"require_valid"`
+	Valid        types.Bool   `tfsdk:"valid"`
+}
+
+func (r *ephemeralConnectorTokensVerification) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = TwingateConnectorTokensVerification
+}
+
+func (r *ephemeralConnectorTokensVerification) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *ephemeralConnectorTokensVerification) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Verifies a Connector's access and refresh token pair with Twingate, e.g. to catch tokens that have been invalidated before deploying a Connector with them.",
+		Attributes: map[string]schema.Attribute{
+			attr.AccessToken: schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The Access Token of the Connector.",
+			},
+			attr.RefreshToken: schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The Refresh Token of the Connector.",
+			},
+			attr.RequireValid: schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether invalid tokens fail the run instead of only setting `valid` to `false`. Default is `false`.",
+			},
+			// computed
+			attr.Valid: schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether Twingate accepted the tokens.",
+			},
+		},
+	}
+}
+
+func (r *ephemeralConnectorTokensVerification) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Client not configured",
+			"The provider client is nil. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var config ephemeralConnectorTokensVerificationModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.VerifyConnectorTokens(ctx, config.RefreshToken.ValueString(), config.AccessToken.ValueString())
+	if err != nil && !client.IsConnectorTokensInvalid(err) {
+		addErr(&resp.Diagnostics, err, operationRead, TwingateConnectorTokensVerification)
+
+		return
+	}
+
+	if err != nil && config.RequireValid.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root(attr.AccessToken), "Connector tokens are not valid", err.Error())
+
+		return
+	}
+
+	config.Valid = types.BoolValue(err == nil)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, config)...)
+}
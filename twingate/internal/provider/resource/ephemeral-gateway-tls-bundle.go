@@ -0,0 +1,254 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/customvalidator"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultGatewayTLSValidity = "90d"
+
+var ErrGatewayCAMismatch = errors.New("CA certificate doesn't match the Gateway's X509 Certificate Authority")
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ ephemeral.EphemeralResource = &ephemeralGatewayTLSBundle{}
+
+func NewEphemeralGatewayTLSBundle() ephemeral.EphemeralResourceWithConfigure {
+	return &ephemeralGatewayTLSBundle{}
+}
+
+type ephemeralGatewayTLSBundle struct {
+	client *client.Client
+}
+
+type ephemeralGatewayTLSBundleModel struct {
+	GatewayID     types.String `tfsdk:"gateway_id"`
+	CACertificate types.String `tfsdk:"ca_certificate"`
+	CAPrivateKey  types.String `tfsdk:"ca_private_key"`
+	DNSNames      types.List   `tfsdk:"dns_names"`
+	IPAddresses   types.List   `tfsdk:"ip_addresses"`
+	Validity      types.String `tfsdk:"validity"`
+	Certificate   types.String `tfsdk:"certificate"`
+	PrivateKey    types.String `tfsdk:"private_key"`
+	Fingerprint   types.String `tfsdk:"fingerprint"`
+	NotAfter      types.String `tfsdk:"not_after"`
+}
+
+func (r *ephemeralGatewayTLSBundle) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = TwingateGatewayTLSBundle
+}
+
+func (r *ephemeralGatewayTLSBundle) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *ephemeralGatewayTLSBundle) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Issues a TLS server certificate for a Gateway from the local CA registered as its X509 Certificate Authority. The certificate covers the Gateway's address and is returned with a new private key, ready to be mounted at `twingate_gateway_config`'s `tls.certificate_file` and `tls.private_key_file`.",
+		Attributes: map[string]schema.Attribute{
+			attr.GatewayID: schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Gateway the certificate is issued for. Its address is the first subject alternative name.",
+			},
+			attr.CACertificate: schema.StringAttribute{
+				Required:    true,
+				Description: "The PEM-encoded CA certificate, optionally followed by its chain. The first certificate must be the one registered with the Gateway's `twingate_x509_certificate_authority`.",
+			},
+			attr.CAPrivateKey: schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The PEM-encoded private key of the CA, in PKCS#8, PKCS#1 or SEC 1 format.",
+			},
+			attr.DNSNames: schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Additional DNS names the certificate is valid for.",
+			},
+			attr.IPAddresses: schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Additional IP addresses the certificate is valid for.",
+			},
+			attr.Validity: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("How long the certificate is valid, e.g. `30d`. It never outlives the CA. Default is `%s`.", defaultGatewayTLSValidity),
+				Validators:  []validator.String{customvalidator.Duration()},
+			},
+			// computed
+			attr.Certificate: schema.StringAttribute{
+				Computed:    true,
+				Description: "The PEM-encoded server certificate followed by `ca_certificate`.",
+			},
+			attr.PrivateKey: schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM-encoded PKCS#8 ECDSA P-256 private key of the server certificate.",
+			},
+			attr.Fingerprint: schema.StringAttribute{
+				Computed:    true,
+				Description: "The SHA-256 fingerprint of the server certificate.",
+			},
+			attr.NotAfter: schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the server certificate expires, in RFC 3339 format.",
+			},
+		},
+	}
+}
+
+func (r *ephemeralGatewayTLSBundle) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Client not configured",
+			"The provider client is nil. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	var config ephemeralGatewayTLSBundleModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	caCerts, err := utils.ParseCertificates(config.CACertificate.ValueString())
+	if err == nil {
+		err = utils.ValidateCACertificates(caCerts[:1], time.Now())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(attr.CACertificate), "Invalid CA certificate", err.Error())
+
+		return
+	}
+
+	caKey, err := utils.ParsePrivateKey(config.CAPrivateKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(attr.CAPrivateKey), "Invalid CA private key", err.Error())
+
+		return
+	}
+
+	gateway, err := r.client.ReadGateway(ctx, config.GatewayID.ValueString())
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationRead, TwingateGatewayTLSBundle)
+
+		return
+	}
+
+	ca, err := r.client.ReadX509CertificateAuthority(ctx, gateway.X509CAID)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationRead, TwingateGatewayTLSBundle)
+
+		return
+	}
+
+	if fingerprint := utils.CertificateFingerprint(caCerts[0]); fingerprint != ca.Fingerprint {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attr.CACertificate),
+			"Invalid CA certificate",
+			fmt.Sprintf("%s: fingerprint %s, expected %s of %q.", ErrGatewayCAMismatch, fingerprint, ca.Fingerprint, ca.Name),
+		)
+
+		return
+	}
+
+	hosts, diags := gatewayTLSHosts(ctx, gateway.Address, config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validity, err := utils.ParseDurationWithDays(withDefaultValue(config.Validity.ValueString(), defaultGatewayTLSValidity))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(attr.Validity), "Invalid validity", err.Error())
+
+		return
+	}
+
+	certificate, privateKey, err := utils.IssueServerCertificate(caCerts[0], caKey, hosts, validity)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, TwingateGatewayTLSBundle)
+
+		return
+	}
+
+	issued, err := utils.ParseCertificates(certificate)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, TwingateGatewayTLSBundle)
+
+		return
+	}
+
+	info := utils.NewCertificateInfo(issued[0])
+
+	config.Certificate = types.StringValue(certificate + strings.TrimSpace(config.CACertificate.ValueString()) + "\n")
+	config.PrivateKey = types.StringValue(privateKey)
+	config.Fingerprint = types.StringValue(info.Fingerprint)
+	config.NotAfter = types.StringValue(info.NotAfter.Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, config)...)
+}
+
+// gatewayTLSHosts returns the Gateway's host followed by the additional names and addresses, without duplicates.
+func gatewayTLSHosts(ctx context.Context, address string, config ephemeralGatewayTLSBundleModel) ([]string, diag.Diagnostics) {
+	var dnsNames, ipAddresses []string
+
+	diags := config.DNSNames.ElementsAs(ctx, &dnsNames, true)
+	diags.Append(config.IPAddresses.ElementsAs(ctx, &ipAddresses, true)...)
+
+	for i, ip := range ipAddresses {
+		if net.ParseIP(ip) == nil {
+			diags.AddAttributeError(path.Root(attr.IPAddresses).AtListIndex(i), "Invalid IP address", fmt.Sprintf("%q is not an IP address.", ip))
+		}
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+
+	hosts := []string{host}
+
+	for _, name := range append(dnsNames, ipAddresses...) {
+		if !slices.Contains(hosts, name) {
+			hosts = append(hosts, name)
+		}
+	}
+
+	return hosts, diags
+}
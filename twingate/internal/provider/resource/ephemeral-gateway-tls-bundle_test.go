@@ -0,0 +1,45 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGatewayTLSHosts(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		address     string
+		dnsNames    []string
+		ipAddresses []string
+		expected    []string
+		expectedErr bool
+	}{
+		{address: "gateway.example.com:8443", expected: []string{"gateway.example.com"}},
+		{address: "10.0.0.1:8443", ipAddresses: []string{"10.0.0.1", "10.0.0.2"}, expected: []string{"10.0.0.1", "10.0.0.2"}},
+		{address: "[::1]:443", dnsNames: []string{"gateway.internal"}, expected: []string{"::1", "gateway.internal"}},
+		{address: "gateway.example.com", dnsNames: []string{"gateway.example.com", "gw.example.com"}, expected: []string{"gateway.example.com", "gw.example.com"}},
+		{address: "gateway.example.com:443", ipAddresses: []string{"not-an-ip"}, expectedErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.address, func(t *testing.T) {
+			dnsNames, _ := types.ListValueFrom(ctx, types.StringType, c.dnsNames)
+			ipAddresses, _ := types.ListValueFrom(ctx, types.StringType, c.ipAddresses)
+
+			hosts, diags := gatewayTLSHosts(ctx, c.address, ephemeralGatewayTLSBundleModel{
+				DNSNames:    dnsNames,
+				IPAddresses: ipAddresses,
+			})
+
+			assert.Equal(t, c.expectedErr, diags.HasError())
+
+			if !c.expectedErr {
+				assert.Equal(t, c.expected, hosts)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package resource
+
+import (
+	"context"
+	"os"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ ephemeral.EphemeralResource = &ephemeralSSHCertificateAuthorityKey{}
+
+func NewEphemeralSSHCertificateAuthorityKey() ephemeral.EphemeralResource {
+	return &ephemeralSSHCertificateAuthorityKey{}
+}
+
+type ephemeralSSHCertificateAuthorityKey struct{}
+
+type ephemeralSSHCertificateAuthorityKeyModel struct {
+	PrivateKeyFile     types.String `tfsdk:"private_key_file"`
+	PublicKey          types.String `tfsdk:"public_key"`
+	PrivateKey         types.String `tfsdk:"private_key"`
+	OpenSSHFingerprint types.String `tfsdk:"openssh_fingerprint"`
+}
+
+func (r *ephemeralSSHCertificateAuthorityKey) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = TwingateSSHCertificateAuthorityKey
+}
+
+func (r *ephemeralSSHCertificateAuthorityKey) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the private key of a `twingate_ssh_certificate_authority` created with `generate_key` without keeping it in state, e.g. to store it in a write-only secret that is mounted at `twingate_gateway_config`'s `ssh.ca.private_key_file`.",
+		Attributes: map[string]schema.Attribute{
+			attr.PrivateKeyFile: schema.StringAttribute{
+				Required:    true,
+				Description: "Path of the private key, i.e. the Certificate Authority's `generate_key.private_key_file`.",
+			},
+			attr.PublicKey: schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The Certificate Authority's `public_key`. When set, the private key must belong to it. Otherwise it's derived from the private key.",
+			},
+			// computed
+			attr.PrivateKey: schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The private key in OpenSSH format.",
+			},
+			attr.OpenSSHFingerprint: schema.StringAttribute{
+				Computed:    true,
+				Description: "The OpenSSH SHA256 fingerprint of the public key, as printed by `ssh-keygen -l`.",
+			},
+		},
+	}
+}
+
+func (r *ephemeralSSHCertificateAuthorityKey) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config ephemeralSSHCertificateAuthorityKeyModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateKey, err := os.ReadFile(config.PrivateKeyFile.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(attr.PrivateKeyFile), "failed to read private key", err.Error())
+
+		return
+	}
+
+	publicKey, err := utils.SSHPublicKeyOfPrivateKey(string(privateKey))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(attr.PrivateKeyFile), "Invalid private key", err.Error())
+
+		return
+	}
+
+	if config.PublicKey.IsNull() {
+		config.PublicKey = types.StringValue(publicKey)
+	} else if err := utils.MatchSSHPublicKeys(config.PublicKey.ValueString(), publicKey); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(attr.PublicKey), "Invalid private key", err.Error())
+
+		return
+	}
+
+	config.PrivateKey = types.StringValue(string(privateKey))
+	config.OpenSSHFingerprint = sshPublicKeyFingerprint(config.PublicKey)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, config)...)
+}
@@ -0,0 +1,83 @@
+package resource
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEphemeralSSHCertificateAuthorityKeyOpen(t *testing.T) {
+	ctx := context.Background()
+
+	publicKey, privateKey, err := utils.GenerateSSHKeyPair(utils.SSHKeyTypeEd25519, "test")
+	assert.NoError(t, err)
+
+	otherKey, _, err := utils.GenerateSSHKeyPair(utils.SSHKeyTypeEd25519, "test")
+	assert.NoError(t, err)
+
+	keyFile := filepath.Join(t.TempDir(), "ca")
+	assert.NoError(t, os.WriteFile(keyFile, []byte(privateKey), secretFileMode))
+
+	resource := NewEphemeralSSHCertificateAuthorityKey()
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	resource.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+	assert.False(t, schemaResp.Diagnostics.HasError())
+
+	keySchema := schemaResp.Schema
+
+	cases := []struct {
+		name          string
+		keyFile       string
+		publicKey     types.String
+		expectedError bool
+	}{
+		{name: "derived public key", keyFile: keyFile, publicKey: types.StringNull()},
+		{name: "matching public key", keyFile: keyFile, publicKey: types.StringValue(publicKey)},
+		{name: "other public key", keyFile: keyFile, publicKey: types.StringValue(otherKey), expectedError: true},
+		{name: "missing file", keyFile: filepath.Join(t.TempDir(), "missing"), publicKey: types.StringNull(), expectedError: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			config := tfsdk.Config{
+				Schema: keySchema,
+				Raw: tftypes.NewValue(keySchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+					"private_key_file":    tftypes.NewValue(tftypes.String, c.keyFile),
+					"public_key":          tftypes.NewValue(tftypes.String, c.publicKey.ValueStringPointer()),
+					"private_key":         tftypes.NewValue(tftypes.String, nil),
+					"openssh_fingerprint": tftypes.NewValue(tftypes.String, nil),
+				}),
+			}
+
+			resp := &ephemeral.OpenResponse{
+				Result: tfsdk.EphemeralResultData{Schema: keySchema, Raw: tftypes.NewValue(keySchema.Type().TerraformType(ctx), nil)},
+			}
+
+			resource.Open(ctx, ephemeral.OpenRequest{Config: config}, resp)
+
+			assert.Equal(t, c.expectedError, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			if c.expectedError {
+				return
+			}
+
+			var result ephemeralSSHCertificateAuthorityKeyModel
+			assert.False(t, resp.Result.Get(ctx, &result).HasError())
+
+			fingerprint, _ := utils.CalculateSSHPublicKeyFingerprint(publicKey)
+
+			assert.Equal(t, publicKey, result.PublicKey.ValueString())
+			assert.Equal(t, privateKey, result.PrivateKey.ValueString())
+			assert.Equal(t, fingerprint, result.OpenSSHFingerprint.ValueString())
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package resource
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"gopkg.in/yaml.v3"
+)
+
+const gatewayConfigSchemaURL = "gateway-config.schema.json"
+
+//go:embed gateway-config.schema.json
+var gatewayConfigSchema string
+
+var schemaPrinter = message.NewPrinter(language.English)
+
+var ErrGatewayConfigSchema = errors.New("rendered configuration doesn't match the Gateway's config schema")
+
+var compileGatewayConfigSchema = sync.OnceValues(func() (*jsonschema.Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(strings.NewReader(gatewayConfigSchema))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse gateway config schema: %w", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(gatewayConfigSchemaURL, doc); err != nil {
+		return nil, fmt.Errorf("failed to load gateway config schema: %w", err)
+	}
+
+	schema, err := compiler.Compile(gatewayConfigSchemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile gateway config schema: %w", err)
+	}
+
+	return schema, nil
+})
+
+// validateContent renders the planned configuration, validates it against the Gateway's config
+// schema and lints it for mistakes the schema can't express.
+func (gateway *gatewayConfigModel) validateContent(ctx context.Context, config providerdata.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data, err := gateway.configData(ctx, config)
+	if err != nil {
+		diags.AddError("Invalid gateway configuration", err.Error())
+
+		return diags
+	}
+
+	content, err := renderGatewayConfig(data)
+	if err == nil {
+		err = validateGatewayConfigSchema(content)
+	}
+
+	if err != nil {
+		diags.AddError("Invalid gateway configuration", err.Error())
+	}
+
+	lintGatewayConfig(data, &diags)
+
+	return diags
+}
+
+// validateGatewayConfigSchema checks the rendered YAML against the embedded JSON Schema.
+func validateGatewayConfigSchema(content string) error {
+	schema, err := compileGatewayConfigSchema()
+	if err != nil {
+		return err
+	}
+
+	var doc any
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return fmt.Errorf("%w: %w", ErrGatewayConfigSchema, err)
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if err := schema.Validate(doc); errors.As(err, &validationErr) {
+		return fmt.Errorf("%w:\n%s", ErrGatewayConfigSchema, strings.Join(schemaViolations(validationErr), "\n"))
+	} else if err != nil {
+		return fmt.Errorf("%w: %w", ErrGatewayConfigSchema, err)
+	}
+
+	return nil
+}
+
+// schemaViolations lists the innermost errors of a validation error, one per violated keyword.
+func schemaViolations(err *jsonschema.ValidationError) []string {
+	if len(err.Causes) == 0 {
+		return []string{fmt.Sprintf("- at %q: %s", "/"+strings.Join(err.InstanceLocation, "/"), err.ErrorKind.LocalizedString(schemaPrinter))}
+	}
+
+	var violations []string
+	for _, cause := range err.Causes {
+		violations = append(violations, schemaViolations(cause)...)
+	}
+
+	return violations
+}
+
+// lintGatewayConfig reports configurations that are valid YAML for the Gateway but don't work as intended.
+func lintGatewayConfig(data gatewayConfigData, diags *diag.Diagnostics) {
+	sshResources := path.Root(attr.SSH).AtName(attr.Resources)
+	k8sResources := path.Root(attr.Kubernetes).AtName(attr.Resources)
+
+	sshNames := make([]string, 0, len(data.SSH.Resources))
+	for _, res := range data.SSH.Resources {
+		sshNames = append(sshNames, res.Name)
+	}
+
+	k8sNames := make([]string, 0, len(data.Kubernetes.Resources))
+	for _, res := range data.Kubernetes.Resources {
+		k8sNames = append(k8sNames, res.Name)
+	}
+
+	for _, name := range duplicateNames(sshNames) {
+		diags.AddAttributeError(sshResources, "Duplicate upstream name", fmt.Sprintf("More than one SSH upstream is named %q.", name))
+	}
+
+	for _, name := range duplicateNames(k8sNames) {
+		diags.AddAttributeError(k8sResources, "Duplicate upstream name", fmt.Sprintf("More than one Kubernetes upstream is named %q.", name))
+	}
+
+	if data.Port == data.MetricsPort {
+		diags.AddAttributeError(path.Root(attr.MetricsPort), "Port conflict",
+			fmt.Sprintf("%q and %q can't both be %d.", attr.Port, attr.MetricsPort, data.Port))
+	}
+
+	for _, res := range data.Kubernetes.Resources {
+		if res.InCluster && res.BearerTokenFile != "" {
+			diags.AddAttributeError(k8sResources, "Conflicting Kubernetes upstream",
+				fmt.Sprintf("Upstream %q sets %q together with %q; in-cluster upstreams use the Gateway's service account token.", res.Name, attr.BearerTokenFile, attr.InCluster))
+		}
+	}
+
+	if len(data.SSH.Resources) > 0 {
+		lintCertificateTTLs(data.SSH.Gateway, diags)
+	}
+}
+
+// lintCertificateTTLs warns when user certificates outlive the host certificate they're used with.
+func lintCertificateTTLs(gateway sshGatewayData, diags *diag.Diagnostics) {
+	hostTTL, hostErr := utils.ParseDurationWithDays(gateway.HostCertTTL)
+	userTTL, userErr := utils.ParseDurationWithDays(gateway.UserCertTTL)
+
+	// Malformed TTLs are reported by the schema.
+	if hostErr != nil || userErr != nil || userTTL <= hostTTL {
+		return
+	}
+
+	diags.AddAttributeWarning(path.Root(attr.SSH).AtName(attr.Gateway).AtName(attr.UserCertTTL), "User certificate outlives host certificate",
+		fmt.Sprintf("%q (%s) is longer than %q (%s); sessions can outlast the host certificate they were established with.",
+			attr.UserCertTTL, gateway.UserCertTTL, attr.HostCertTTL, gateway.HostCertTTL))
+}
+
+// duplicateNames returns the names that occur more than once, in order of their second occurrence.
+func duplicateNames(names []string) []string {
+	seen := make(map[string]int, len(names))
+
+	var duplicates []string
+
+	for _, name := range names {
+		seen[name]++
+
+		if seen[name] == 2 { //nolint:mnd
+			duplicates = append(duplicates, name)
+		}
+	}
+
+	return duplicates
+}
@@ -0,0 +1,139 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	fwattr "github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func k8sItemWithBearerToken(name, address string, inCluster bool, bearerTokenFile string) map[string]fwattr.Value {
+	item := k8sItem(name, address, inCluster)
+	item["bearer_token_file"] = types.StringValue(bearerTokenFile)
+
+	return item
+}
+
+func lintTestModel(ssh types.Object, k8s types.Object) gatewayConfigModel {
+	return gatewayConfigModel{
+		Port:        types.Int64Value(defaultPort),
+		MetricsPort: types.Int64Value(defaultMetricsPort),
+		SSH:         ssh,
+		Kubernetes:  k8s,
+		TLS:         defaultTLS(),
+	}
+}
+
+func diagSummaries(diags diag.Diagnostics) []string {
+	summaries := make([]string, 0, len(diags))
+	for _, d := range diags {
+		summaries = append(summaries, d.Severity().String()+": "+d.Summary())
+	}
+
+	return summaries
+}
+
+func TestGatewayConfigValidateContent(t *testing.T) {
+	ctx := context.Background()
+
+	baseSsh := makeSshList(sshItem("ssh-1", "10.0.0.1", "admin"))
+
+	samePorts := lintTestModel(defaultSshObj(baseSsh), defaultK8sObj())
+	samePorts.MetricsPort = types.Int64Value(defaultPort)
+
+	cases := []struct {
+		name             string
+		model            gatewayConfigModel
+		expected         []string
+		expectedInDetail string
+	}{
+		{
+			name:  "valid configuration",
+			model: lintTestModel(defaultSshObj(baseSsh), makeK8sObj(makeK8sList(k8sItem("k8s-1", "10.0.0.2:6443", true)))),
+		},
+		{
+			name:     "duplicate ssh upstream names",
+			model:    lintTestModel(defaultSshObj(makeSshList(sshItem("web", "10.0.0.1", ""), sshItem("web", "10.0.0.2", ""))), defaultK8sObj()),
+			expected: []string{"Error: Duplicate upstream name"},
+		},
+		{
+			name: "duplicate kubernetes upstream names",
+			model: lintTestModel(defaultSshObj(makeSshList()), makeK8sObj(makeK8sList(
+				k8sItem("cluster", "10.0.0.2:6443", false), k8sItem("cluster", "10.0.0.3:6443", false),
+			))),
+			expected: []string{"Error: Duplicate upstream name"},
+		},
+		{
+			name:     "same name for ssh and kubernetes upstreams",
+			model:    lintTestModel(defaultSshObj(makeSshList(sshItem("web", "10.0.0.1", ""))), makeK8sObj(makeK8sList(k8sItem("web", "10.0.0.2:6443", true)))),
+			expected: nil,
+		},
+		{
+			name:     "port overlaps metrics port",
+			model:    samePorts,
+			expected: []string{"Error: Port conflict"},
+		},
+		{
+			name:     "user certificate outlives host certificate",
+			model:    lintTestModel(makeSshObj(customSshGateway("gateway", "ed25519", "1h", "2h"), defaultSshCA(), baseSsh), defaultK8sObj()),
+			expected: []string{"Warning: User certificate outlives host certificate"},
+		},
+		{
+			name:     "certificate TTLs are ignored without ssh upstreams",
+			model:    lintTestModel(makeSshObj(customSshGateway("gateway", "ed25519", "1h", "2h"), defaultSshCA(), makeSshList()), makeK8sObj(makeK8sList(k8sItem("k8s-1", "10.0.0.2:6443", true)))),
+			expected: nil,
+		},
+		{
+			name:     "in cluster upstream with bearer token file",
+			model:    lintTestModel(defaultSshObj(makeSshList()), makeK8sObj(makeK8sList(k8sItemWithBearerToken("k8s-1", "10.0.0.2:6443", true, "/var/run/token")))),
+			expected: []string{"Error: Conflicting Kubernetes upstream"},
+		},
+		{
+			name:     "external upstream with bearer token file",
+			model:    lintTestModel(defaultSshObj(makeSshList()), makeK8sObj(makeK8sList(k8sItemWithBearerToken("k8s-1", "10.0.0.2:6443", false, "/var/run/token")))),
+			expected: nil,
+		},
+		{
+			name:             "malformed ttl",
+			model:            lintTestModel(makeSshObj(customSshGateway("gateway", "ed25519", "24h", "5 minutes"), defaultSshCA(), baseSsh), defaultK8sObj()),
+			expected:         []string{"Error: Invalid gateway configuration"},
+			expectedInDetail: `at "/ssh/gateway/userCertificate/ttl"`,
+		},
+		{
+			name:             "empty upstream name",
+			model:            lintTestModel(defaultSshObj(makeSshList(sshItem("", "10.0.0.1", ""))), defaultK8sObj()),
+			expected:         []string{"Error: Invalid gateway configuration"},
+			expectedInDetail: `at "/ssh/upstreams/0/name"`,
+		},
+		{
+			name:             "gcp iam auth without service account email",
+			model:            lintTestModel(makeSshObj(defaultSshGateway(), sshCAWithVaultAndGCP("https://vault.example.com", "my-role", "iam"), baseSsh), defaultK8sObj()),
+			expected:         []string{"Error: Invalid gateway configuration"},
+			expectedInDetail: `at "/ssh/ca/vault/auth/gcp"`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			diags := c.model.validateContent(ctx, baseConfig)
+
+			if c.expected == nil {
+				assert.Empty(t, diagSummaries(diags))
+			} else {
+				assert.Equal(t, c.expected, diagSummaries(diags))
+			}
+
+			if c.expectedInDetail != "" && len(diags) > 0 {
+				assert.Contains(t, diags[0].Detail(), c.expectedInDetail)
+			}
+		})
+	}
+}
+
+func TestDuplicateNames(t *testing.T) {
+	assert.Empty(t, duplicateNames([]string{"a", "b", "c"}))
+	assert.Equal(t, []string{"b", "a"}, duplicateNames([]string{"a", "b", "b", "a", "b"}))
+}
@@ -2,14 +2,17 @@ package resource
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"crypto/sha256"
 	_ "embed"
 	"errors"
 	"fmt"
+	"slices"
 	"text/template"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/customvalidator"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -63,6 +66,12 @@ var (
 	ErrFailedDecodeVault                = errors.New("failed to decode ssh.ca.vault configuration")
 	ErrAtLeastOnePrivateKeyOrAddressSet = errors.New(`At least one of "ssh.ca.private_key_file" or "ssh.ca.vault.address" must be set.`)
 	ErrAuthNotSet                       = errors.New("ssh.ca.vault.auth must be set")
+	ErrExtractDiscoveredResources       = errors.New("failed to extract discovered_resources")
+)
+
+const (
+	discoveredResourceTypeSSH        = "ssh"
+	discoveredResourceTypeKubernetes = "kubernetes"
 )
 
 //go:embed gateway-config.tmpl.yaml
@@ -70,6 +79,7 @@ var gatewayConfigTemplate string
 
 var _ resource.Resource = &gatewayConfig{}
 var _ resource.ResourceWithValidateConfig = &gatewayConfig{}
+var _ resource.ResourceWithModifyPlan = &gatewayConfig{}
 
 func NewGatewayConfigResource() resource.Resource {
 	return &gatewayConfig{}
@@ -77,16 +87,19 @@ func NewGatewayConfigResource() resource.Resource {
 
 type gatewayConfig struct {
 	ProviderConfig providerdata.Config
+	client         *client.Client
 }
 
 type gatewayConfigModel struct {
-	ID          types.String `tfsdk:"id"`
-	Port        types.Int64  `tfsdk:"port"`
-	MetricsPort types.Int64  `tfsdk:"metrics_port"`
-	TLS         types.Object `tfsdk:"tls"`
-	SSH         types.Object `tfsdk:"ssh"`
-	Kubernetes  types.Object `tfsdk:"kubernetes"`
-	Content     types.String `tfsdk:"content"`
+	ID                  types.String `tfsdk:"id"`
+	GatewayID           types.String `tfsdk:"gateway_id"`
+	DiscoveredResources types.List   `tfsdk:"discovered_resources"`
+	Port                types.Int64  `tfsdk:"port"`
+	MetricsPort         types.Int64  `tfsdk:"metrics_port"`
+	TLS                 types.Object `tfsdk:"tls"`
+	SSH                 types.Object `tfsdk:"ssh"`
+	Kubernetes          types.Object `tfsdk:"kubernetes"`
+	Content             types.String `tfsdk:"content"`
 }
 
 type kubernetesModel struct {
@@ -200,9 +213,16 @@ type sshResourceRef struct {
 }
 
 type kubernetesResourceRef struct {
-	Name      types.String `tfsdk:"name"`
-	Address   types.String `tfsdk:"address"`
-	InCluster types.Bool   `tfsdk:"in_cluster"`
+	Name            types.String `tfsdk:"name"`
+	Address         types.String `tfsdk:"address"`
+	InCluster       types.Bool   `tfsdk:"in_cluster"`
+	BearerTokenFile types.String `tfsdk:"bearer_token_file"`
+}
+
+type discoveredResourceModel struct {
+	Type    types.String `tfsdk:"type"`
+	Name    types.String `tfsdk:"name"`
+	Address types.String `tfsdk:"address"`
 }
 
 type gatewayConfigData struct {
@@ -269,9 +289,10 @@ type sshResourceData struct {
 }
 
 type kubernetesResourceData struct {
-	Name      string
-	Address   string
-	InCluster bool
+	Name            string
+	Address         string
+	InCluster       bool
+	BearerTokenFile string
 }
 
 func tlsAttrTypes() map[string]fwattr.Type {
@@ -336,9 +357,20 @@ func sshResourceElemType() fwattr.Type {
 func kubernetesResourceElemType() fwattr.Type {
 	return types.ObjectType{
 		AttrTypes: map[string]fwattr.Type{
-			attr.Name:      types.StringType,
-			attr.Address:   types.StringType,
-			attr.InCluster: types.BoolType,
+			attr.Name:            types.StringType,
+			attr.Address:         types.StringType,
+			attr.InCluster:       types.BoolType,
+			attr.BearerTokenFile: types.StringType,
+		},
+	}
+}
+
+func discoveredResourceElemType() fwattr.Type {
+	return types.ObjectType{
+		AttrTypes: map[string]fwattr.Type{
+			attr.Type:    types.StringType,
+			attr.Name:    types.StringType,
+			attr.Address: types.StringType,
 		},
 	}
 }
@@ -421,12 +453,13 @@ func (r *gatewayConfig) Configure(_ context.Context, req resource.ConfigureReque
 	}
 
 	r.ProviderConfig = providerData.Config
+	r.client = providerData.Client
 }
 
 //nolint:funlen
 func (r *gatewayConfig) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Generates a Gateway configuration YAML from SSH and Kubernetes resources.",
+		Description: "Generates a Gateway configuration YAML from SSH and Kubernetes resources. The rendered configuration is validated against the Gateway's config schema at plan time.",
 		Attributes: map[string]schema.Attribute{
 			attr.ID: schema.StringAttribute{
 				Computed:    true,
@@ -435,6 +468,17 @@ func (r *gatewayConfig) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			attr.GatewayID: schema.StringAttribute{
+				Optional: true,
+				Description: "The ID of a Gateway whose SSH and Kubernetes resources are discovered through the API and rendered as upstreams. " +
+					"Entries of `ssh.resources` and `kubernetes.resources` override discovered resources with the same name and are rendered in addition to them otherwise. " +
+					"Resources added to or removed from the Gateway outside of this configuration show up as a change of `discovered_resources` in the plan.",
+			},
+			attr.DiscoveredResources: schema.ListAttribute{
+				Computed:    true,
+				ElementType: discoveredResourceElemType(),
+				Description: "The resources discovered for `gateway_id`, sorted by type and name. Each has a `type` (`ssh` or `kubernetes`), `name` and `address`.",
+			},
 			attr.Port: schema.Int64Attribute{
 				Optional:    true,
 				Computed:    true,
@@ -621,10 +665,26 @@ func (r *gatewayConfig) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					attr.Resources: types.ListValueMust(kubernetesResourceElemType(), []fwattr.Value{}),
 				})),
 				Attributes: map[string]schema.Attribute{
-					attr.Resources: schema.ListAttribute{
+					attr.Resources: schema.ListNestedAttribute{
 						Optional:    true,
 						Description: "List of Kubernetes resources. Accepts full twingate_kubernetes_resource references.",
-						ElementType: kubernetesResourceElemType(),
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								attr.Name: schema.StringAttribute{
+									Optional: true,
+								},
+								attr.Address: schema.StringAttribute{
+									Optional: true,
+								},
+								attr.InCluster: schema.BoolAttribute{
+									Optional: true,
+								},
+								attr.BearerTokenFile: schema.StringAttribute{
+									Optional:    true,
+									Description: "Path to the bearer token file used to reach the cluster. Can't be used together with in_cluster.",
+								},
+							},
+						},
 					},
 				},
 			},
@@ -640,26 +700,35 @@ func (r *gatewayConfig) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
-//nolint:funlen
 func (gateway *gatewayConfigModel) generateContent(ctx context.Context, config providerdata.Config) (string, error) {
+	data, err := gateway.configData(ctx, config)
+	if err != nil {
+		return "", err
+	}
+
+	return renderGatewayConfig(data)
+}
+
+//nolint:funlen
+func (gateway *gatewayConfigModel) configData(ctx context.Context, config providerdata.Config) (gatewayConfigData, error) {
 	var sshConf sshModel
 	if diags := gateway.SSH.As(ctx, &sshConf, basetypes.ObjectAsOptions{}); diags.HasError() {
-		return "", ErrExtractSSH
+		return gatewayConfigData{}, ErrExtractSSH
 	}
 
 	var sshRefs []sshResourceRef
 	if diags := sshConf.Resources.ElementsAs(ctx, &sshRefs, false); diags.HasError() {
-		return "", ErrExtractSSHResources
+		return gatewayConfigData{}, ErrExtractSSHResources
 	}
 
 	var k8sConf kubernetesModel
 	if diags := gateway.Kubernetes.As(ctx, &k8sConf, basetypes.ObjectAsOptions{}); diags.HasError() {
-		return "", ErrExtractKubernetes
+		return gatewayConfigData{}, ErrExtractKubernetes
 	}
 
 	var k8sRefs []kubernetesResourceRef
 	if diags := k8sConf.Resources.ElementsAs(ctx, &k8sRefs, false); diags.HasError() {
-		return "", ErrExtractKubernetesResources
+		return gatewayConfigData{}, ErrExtractKubernetesResources
 	}
 
 	sshItems := make([]sshResourceData, 0, len(sshRefs))
@@ -674,43 +743,53 @@ func (gateway *gatewayConfigModel) generateContent(ctx context.Context, config p
 	k8sItems := make([]kubernetesResourceData, 0, len(k8sRefs))
 	for _, k := range k8sRefs {
 		k8sItems = append(k8sItems, kubernetesResourceData{
-			Name:      k.Name.ValueString(),
-			Address:   k.Address.ValueString(),
-			InCluster: k.InCluster.ValueBool(),
+			Name:            k.Name.ValueString(),
+			Address:         k.Address.ValueString(),
+			InCluster:       k.InCluster.ValueBool(),
+			BearerTokenFile: k.BearerTokenFile.ValueString(),
 		})
 	}
 
+	var discovered []discoveredResourceModel
+	if !gateway.DiscoveredResources.IsNull() && !gateway.DiscoveredResources.IsUnknown() {
+		if diags := gateway.DiscoveredResources.ElementsAs(ctx, &discovered, false); diags.HasError() {
+			return gatewayConfigData{}, ErrExtractDiscoveredResources
+		}
+	}
+
+	sshItems, k8sItems = mergeDiscoveredResources(discovered, sshItems, k8sItems)
+
 	var tlsGW tlsModel
 	if diags := gateway.TLS.As(ctx, &tlsGW, basetypes.ObjectAsOptions{}); diags.HasError() {
-		return "", ErrExtractTLS
+		return gatewayConfigData{}, ErrExtractTLS
 	}
 
 	var sshGW sshGatewayModel
 	if diags := sshConf.Gateway.As(ctx, &sshGW, basetypes.ObjectAsOptions{}); diags.HasError() {
-		return "", ErrExtractSSHGateway
+		return gatewayConfigData{}, ErrExtractSSHGateway
 	}
 
 	var sshCA sshCAModel
 	if diags := sshConf.CA.As(ctx, &sshCA, basetypes.ObjectAsOptions{}); diags.HasError() {
-		return "", ErrExtractSSHCA
+		return gatewayConfigData{}, ErrExtractSSHCA
 	}
 
 	var vaultConf vaultModel
 	if diags := sshCA.Vault.As(ctx, &vaultConf, basetypes.ObjectAsOptions{}); diags.HasError() {
-		return "", ErrExtractVault
+		return gatewayConfigData{}, ErrExtractVault
 	}
 
 	var authConf authModel
 	if diags := vaultConf.Auth.As(ctx, &authConf, basetypes.ObjectAsOptions{}); diags.HasError() {
-		return "", ErrExtractAuth
+		return gatewayConfigData{}, ErrExtractAuth
 	}
 
 	var gcpConf gcpModel
 	if diags := authConf.GCP.As(ctx, &gcpConf, basetypes.ObjectAsOptions{}); diags.HasError() {
-		return "", ErrExtractGCP
+		return gatewayConfigData{}, ErrExtractGCP
 	}
 
-	data := gatewayConfigData{
+	return gatewayConfigData{
 		TwingateNetwork: config.Network,
 		TwingateHost:    config.URL,
 		Port:            gateway.Port.ValueInt64(),
@@ -749,8 +828,10 @@ func (gateway *gatewayConfigModel) generateContent(ctx context.Context, config p
 		Kubernetes: kubernetesData{
 			Resources: k8sItems,
 		},
-	}
+	}, nil
+}
 
+func renderGatewayConfig(data gatewayConfigData) (string, error) {
 	tmpl, err := template.New(gatewayConfigFilename).Parse(gatewayConfigTemplate)
 	if err != nil {
 		return "", fmt.Errorf("failed to parse gateway config template: %w", err)
@@ -785,6 +866,19 @@ func (r *gatewayConfig) storeContent(ctx context.Context, getter Getter, setter
 		return
 	}
 
+	if state.GatewayID.IsNull() {
+		state.DiscoveredResources = types.ListNull(discoveredResourceElemType())
+	} else if state.DiscoveredResources.IsUnknown() {
+		discovered, err := r.discoverResources(ctx, state.GatewayID.ValueString())
+		if err != nil {
+			addErr(diagnostics, err, operation, TwingateGatewayConfig)
+
+			return
+		}
+
+		state.DiscoveredResources = discovered
+	}
+
 	content, err := state.generateContent(ctx, r.ProviderConfig)
 	if err != nil {
 		addErr(diagnostics, err, operation, TwingateGatewayConfig)
@@ -798,6 +892,155 @@ func (r *gatewayConfig) storeContent(ctx context.Context, getter Getter, setter
 	diagnostics.Append(setter.Set(ctx, &state)...)
 }
 
+// ModifyPlan discovers the Gateway's resources on every plan, so that resources added to or removed
+// from the Gateway outside of this configuration replace the rendered content, and validates the
+// configuration the Gateway will be given.
+func (r *gatewayConfig) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip on delete (plan is null).
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan gatewayConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client != nil {
+		plan.DiscoveredResources = r.planDiscoveredResources(ctx, req, resp, plan.GatewayID)
+	}
+
+	// Values known only after apply would render as empty strings.
+	if resp.Diagnostics.HasError() || !req.Config.Raw.IsFullyKnown() || plan.DiscoveredResources.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(plan.validateContent(ctx, r.ProviderConfig)...)
+}
+
+func (r *gatewayConfig) planDiscoveredResources(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, gatewayID types.String) types.List {
+	if gatewayID.IsUnknown() {
+		return types.ListUnknown(discoveredResourceElemType())
+	}
+
+	if gatewayID.IsNull() {
+		discovered := types.ListNull(discoveredResourceElemType())
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attr.DiscoveredResources), discovered)...)
+
+		return discovered
+	}
+
+	discovered, err := r.discoverResources(ctx, gatewayID.ValueString())
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationRead, TwingateGatewayConfig)
+
+		return types.ListUnknown(discoveredResourceElemType())
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attr.DiscoveredResources), discovered)...)
+
+	// Skip drift detection on create (state is null).
+	if req.State.Raw.IsNull() {
+		return discovered
+	}
+
+	var stateDiscovered types.List
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(attr.DiscoveredResources), &stateDiscovered)...)
+
+	if resp.Diagnostics.HasError() || stateDiscovered.Equal(discovered) {
+		return discovered
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attr.Content), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attr.ID), types.StringUnknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root(attr.Content))
+
+	return discovered
+}
+
+// discoverResources returns the SSH and Kubernetes resources bound to the Gateway, sorted by type and name.
+func (r *gatewayConfig) discoverResources(ctx context.Context, gatewayID string) (types.List, error) {
+	sshResources, k8sResources, err := r.client.ReadGatewayResources(ctx, gatewayID)
+	if err != nil {
+		return types.ListNull(discoveredResourceElemType()), err //nolint:wrapcheck
+	}
+
+	discovered := make([]discoveredResourceModel, 0, len(sshResources)+len(k8sResources))
+
+	for _, res := range sshResources {
+		discovered = append(discovered, newDiscoveredResource(discoveredResourceTypeSSH, res.Name, res.Address))
+	}
+
+	for _, res := range k8sResources {
+		discovered = append(discovered, newDiscoveredResource(discoveredResourceTypeKubernetes, res.Name, res.Address))
+	}
+
+	slices.SortStableFunc(discovered, func(a, b discoveredResourceModel) int {
+		return cmp.Or(
+			cmp.Compare(a.Type.ValueString(), b.Type.ValueString()),
+			cmp.Compare(a.Name.ValueString(), b.Name.ValueString()),
+		)
+	})
+
+	list, diags := types.ListValueFrom(ctx, discoveredResourceElemType(), discovered)
+	if diags.HasError() {
+		return list, ErrExtractDiscoveredResources
+	}
+
+	return list, nil
+}
+
+func newDiscoveredResource(resourceType, name, address string) discoveredResourceModel {
+	return discoveredResourceModel{
+		Type:    types.StringValue(resourceType),
+		Name:    types.StringValue(name),
+		Address: types.StringValue(address),
+	}
+}
+
+// mergeDiscoveredResources renders the discovered resources first, replacing those with the name of an
+// explicit entry, followed by the remaining explicit entries.
+func mergeDiscoveredResources(discovered []discoveredResourceModel, sshItems []sshResourceData, k8sItems []kubernetesResourceData) ([]sshResourceData, []kubernetesResourceData) {
+	mergedSSH := make([]sshResourceData, 0, len(discovered)+len(sshItems))
+	mergedK8s := make([]kubernetesResourceData, 0, len(discovered)+len(k8sItems))
+
+	for _, res := range discovered {
+		name, address := res.Name.ValueString(), res.Address.ValueString()
+
+		switch res.Type.ValueString() {
+		case discoveredResourceTypeSSH:
+			mergedSSH = append(mergedSSH, sshResourceData{Name: name, Address: address})
+		case discoveredResourceTypeKubernetes:
+			mergedK8s = append(mergedK8s, kubernetesResourceData{Name: name, Address: address, InCluster: address == defaultKubernetesAddress})
+		}
+	}
+
+	// Only discovered entries are overridden, duplicate explicit entries are left to the lints.
+	discoveredSSH, discoveredK8s := len(mergedSSH), len(mergedK8s)
+
+	for _, item := range sshItems {
+		if idx := slices.IndexFunc(mergedSSH[:discoveredSSH], func(res sshResourceData) bool { return res.Name == item.Name }); idx >= 0 {
+			mergedSSH[idx] = item
+		} else {
+			mergedSSH = append(mergedSSH, item)
+		}
+	}
+
+	for _, item := range k8sItems {
+		if idx := slices.IndexFunc(mergedK8s[:discoveredK8s], func(res kubernetesResourceData) bool { return res.Name == item.Name }); idx >= 0 {
+			mergedK8s[idx] = item
+		} else {
+			mergedK8s = append(mergedK8s, item)
+		}
+	}
+
+	return mergedSSH, mergedK8s
+}
+
 func (r *gatewayConfig) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
 	// Nothing to delete - purely local resource.
 }
@@ -831,10 +1074,10 @@ func (r *gatewayConfig) ValidateConfig(ctx context.Context, req resource.Validat
 		}
 	}
 
-	if sshConf.IsEmptyResources() && k8sConf.IsEmptyResources() {
+	if cfg.GatewayID.IsNull() && sshConf.IsEmptyResources() && k8sConf.IsEmptyResources() {
 		resp.Diagnostics.AddError(
 			"Invalid configuration",
-			`At least one of "ssh.resources" or "kubernetes.resources" must contain one or more items.`,
+			`At least one of "gateway_id", "ssh.resources" or "kubernetes.resources" must be set.`,
 		)
 	}
 
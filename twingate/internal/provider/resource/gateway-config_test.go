@@ -216,9 +216,10 @@ var (
 
 	k8sElemType = types.ObjectType{
 		AttrTypes: map[string]fwattr.Type{
-			"name":       types.StringType,
-			"address":    types.StringType,
-			"in_cluster": types.BoolType,
+			"name":              types.StringType,
+			"address":           types.StringType,
+			"in_cluster":        types.BoolType,
+			"bearer_token_file": types.StringType,
 		},
 	}
 
@@ -285,9 +286,10 @@ func sshItem(name, address, username string) map[string]fwattr.Value {
 
 func k8sItem(name, address string, inCluster bool) map[string]fwattr.Value {
 	return map[string]fwattr.Value{
-		"name":       types.StringValue(name),
-		"address":    types.StringValue(address),
-		"in_cluster": types.BoolValue(inCluster),
+		"name":              types.StringValue(name),
+		"address":           types.StringValue(address),
+		"in_cluster":        types.BoolValue(inCluster),
+		"bearer_token_file": types.StringNull(),
 	}
 }
 
@@ -632,8 +634,73 @@ func TestGatewayConfigGenerateContent(t *testing.T) {
 			var doc map[string]any
 			err = yaml.Unmarshal([]byte(content), &doc)
 			assert.NoError(t, err, "generated content must be valid YAML:\n%s", content)
+			assert.NoError(t, validateGatewayConfigSchema(content), "generated content must match the schema")
 
 			tc.checkYAML(t, doc)
 		})
 	}
 }
+
+func TestMergeDiscoveredResources(t *testing.T) {
+	discovered := []discoveredResourceModel{
+		newDiscoveredResource(discoveredResourceTypeKubernetes, "k8s-1", defaultKubernetesAddress),
+		newDiscoveredResource(discoveredResourceTypeKubernetes, "k8s-2", "10.0.0.3:6443"),
+		newDiscoveredResource(discoveredResourceTypeSSH, "ssh-1", "10.0.0.1"),
+		newDiscoveredResource(discoveredResourceTypeSSH, "ssh-2", "10.0.0.2"),
+	}
+
+	sshItems, k8sItems := mergeDiscoveredResources(discovered,
+		[]sshResourceData{
+			{Name: "ssh-2", Address: "ssh-2.internal", Username: "admin"},
+			{Name: "ssh-3", Address: "10.0.0.4", Username: "root"},
+		},
+		[]kubernetesResourceData{
+			{Name: "k8s-2", Address: "10.0.0.3:6443", InCluster: true},
+		},
+	)
+
+	assert.Equal(t, []sshResourceData{
+		{Name: "ssh-1", Address: "10.0.0.1"},
+		{Name: "ssh-2", Address: "ssh-2.internal", Username: "admin"},
+		{Name: "ssh-3", Address: "10.0.0.4", Username: "root"},
+	}, sshItems)
+
+	assert.Equal(t, []kubernetesResourceData{
+		{Name: "k8s-1", Address: defaultKubernetesAddress, InCluster: true},
+		{Name: "k8s-2", Address: "10.0.0.3:6443", InCluster: true},
+	}, k8sItems)
+}
+
+func TestGatewayConfigGenerateContentWithDiscoveredResources(t *testing.T) {
+	ctx := context.Background()
+
+	discovered, diags := types.ListValueFrom(ctx, discoveredResourceElemType(), []discoveredResourceModel{
+		newDiscoveredResource(discoveredResourceTypeKubernetes, "k8s-1", defaultKubernetesAddress),
+		newDiscoveredResource(discoveredResourceTypeSSH, "ssh-1", "10.0.0.1"),
+	})
+	assert.False(t, diags.HasError())
+
+	model := gatewayConfigModel{
+		Port:                types.Int64Value(defaultPort),
+		MetricsPort:         types.Int64Value(defaultMetricsPort),
+		SSH:                 makeSshObj(defaultSshGateway(), sshCAWithPrivateKey("/etc/ssh/ca"), makeSshList()),
+		Kubernetes:          makeK8sObj(makeK8sList()),
+		TLS:                 defaultTLS(),
+		GatewayID:           types.StringValue("gw-1"),
+		DiscoveredResources: discovered,
+	}
+
+	content, err := model.generateContent(ctx, baseConfig)
+	assert.NoError(t, err)
+
+	var doc map[string]any
+	assert.NoError(t, yaml.Unmarshal([]byte(content), &doc))
+
+	sshUpstreams := doc["ssh"].(map[string]any)["upstreams"].([]any)
+	assert.Len(t, sshUpstreams, 1)
+	assert.Equal(t, "10.0.0.1:22", sshUpstreams[0].(map[string]any)["address"])
+
+	k8sUpstreams := doc["kubernetes"].(map[string]any)["upstreams"].([]any)
+	assert.Len(t, k8sUpstreams, 1)
+	assert.Equal(t, true, k8sUpstreams[0].(map[string]any)["inCluster"])
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/customvalidator"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -32,11 +33,13 @@ type gateway struct {
 }
 
 type gatewayModel struct {
-	ID              types.String `tfsdk:"id"`
-	RemoteNetworkID types.String `tfsdk:"remote_network_id"`
-	Address         types.String `tfsdk:"address"`
-	X509CAID        types.String `tfsdk:"x509_ca_id"`
-	SSHCAID         types.String `tfsdk:"ssh_ca_id"`
+	ID                 types.String   `tfsdk:"id"`
+	RemoteNetworkID    types.String   `tfsdk:"remote_network_id"`
+	Address            types.String   `tfsdk:"address"`
+	X509CAID           types.String   `tfsdk:"x509_ca_id"`
+	SSHCAID            types.String   `tfsdk:"ssh_ca_id"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *gateway) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -60,7 +63,7 @@ func (r *gateway) ImportState(ctx context.Context, req resource.ImportStateReque
 	resource.ImportStatePassthroughID(ctx, path.Root(attr.ID), req, resp)
 }
 
-func (r *gateway) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *gateway) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Gateways are the Twingate components that route traffic to remote networks.",
 		Attributes: map[string]schema.Attribute{
@@ -90,6 +93,10 @@ func (r *gateway) Schema(_ context.Context, _ resource.SchemaRequest, resp *reso
 				Optional:    true,
 				Description: "The ID of the SSH Certificate Authority used for SSH access.",
 			},
+			attr.DeletionProtection: deletionProtectionAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			attr.Timeouts: timeoutsBlock(ctx),
 		},
 	}
 }
@@ -99,6 +106,9 @@ func (r *gateway) Create(ctx context.Context, req resource.CreateRequest, resp *
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -118,6 +128,9 @@ func (r *gateway) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -132,6 +145,9 @@ func (r *gateway) Update(ctx context.Context, req resource.UpdateRequest, resp *
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Read remote_network_id from state (required by API, value never changes due to RequiresReplace).
 	var state gatewayModel
 
@@ -157,10 +173,17 @@ func (r *gateway) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if isDeletionProtected(state.DeletionProtection, &resp.Diagnostics, TwingateGateway) {
+		return
+	}
+
 	err := r.client.DeleteGateway(ctx, state.ID.ValueString())
 	addErr(&resp.Diagnostics, err, operationDelete, TwingateGateway)
 }
@@ -182,6 +205,7 @@ func (r *gateway) helper(ctx context.Context, gateway *model.Gateway, state *gat
 	state.Address = types.StringValue(gateway.Address)
 	state.RemoteNetworkID = types.StringValue(gateway.RemoteNetworkID)
 	state.X509CAID = types.StringValue(gateway.X509CAID)
+	state.DeletionProtection = deletionProtectionValue(state.DeletionProtection)
 
 	if gateway.SSHCAID != "" {
 		state.SSHCAID = types.StringValue(gateway.SSHCAID)
@@ -56,6 +56,7 @@ func upgradeGroupStateV0() resource.StateUpgrader {
 				Name:            priorState.Name,
 				IsAuthoritative: priorState.IsAuthoritative,
 				UserIDs:         priorState.UserIDs,
+				Timeouts:        nullTimeouts(ctx),
 			}
 
 			resp.Diagnostics.Append(resp.State.Set(ctx, upgradedState)...)
@@ -45,6 +45,7 @@ func TestGroupStateUpgraderV0(t *testing.T) {
 					Name:            types.StringValue("test-name"),
 					IsAuthoritative: types.BoolValue(true),
 					UserIDs:         userIDs,
+					Timeouts:        nullTimeouts(context.TODO()),
 				}
 			},
 		},
@@ -10,6 +10,7 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -37,10 +38,12 @@ type group struct {
 }
 
 type groupModel struct {
-	ID              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	IsAuthoritative types.Bool   `tfsdk:"is_authoritative"`
-	UserIDs         types.Set    `tfsdk:"user_ids"`
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	IsAuthoritative    types.Bool     `tfsdk:"is_authoritative"`
+	UserIDs            types.Set      `tfsdk:"user_ids"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *group) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -61,16 +64,23 @@ func (r *group) Configure(_ context.Context, req resource.ConfigureRequest, _ *r
 }
 
 func (r *group) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root(attr.ID), req, resp)
+	id, err := resolveGroupImportID(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to import state", err.Error())
 
-	res, err := r.client.ReadGroup(ctx, req.ID)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.ID), id)...)
+
+	res, err := r.client.ReadGroup(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to import state", err.Error())
 
 		return
 	}
 
-	resp.State.SetAttribute(ctx, path.Root(attr.IsAuthoritative), types.BoolValue(res.IsAuthoritative))
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.IsAuthoritative), types.BoolValue(res.IsAuthoritative))...)
 
 	userIDs, diags := types.SetValueFrom(ctx, types.StringType, res.Users)
 	resp.Diagnostics.Append(diags...)
@@ -79,10 +89,10 @@ func (r *group) ImportState(ctx context.Context, req resource.ImportStateRequest
 		return
 	}
 
-	resp.State.SetAttribute(ctx, path.Root(attr.UserIDs), userIDs)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.UserIDs), userIDs)...)
 }
 
-func (r *group) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *group) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Version:     1,
 		Description: "Groups are how users are authorized to access Resources. For more information, see Twingate's [documentation](https://docs.twingate.com/docs/groups).",
@@ -101,6 +111,7 @@ func (r *group) Schema(_ context.Context, _ resource.SchemaRequest, resp *resour
 				Optional:    true,
 				Description: "List of User IDs that have permission to access the Group.",
 			},
+			attr.DeletionProtection: deletionProtectionAttribute(),
 			// computed
 			attr.ID: schema.StringAttribute{
 				Computed:      true,
@@ -108,6 +119,9 @@ func (r *group) Schema(_ context.Context, _ resource.SchemaRequest, resp *resour
 				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			attr.Timeouts: timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -123,6 +137,9 @@ func (r *group) Create(ctx context.Context, req resource.CreateRequest, resp *re
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -137,6 +154,9 @@ func (r *group) Read(ctx context.Context, req resource.ReadRequest, resp *resour
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -155,6 +175,9 @@ func (r *group) Update(ctx context.Context, req resource.UpdateRequest, resp *re
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	group := convertGroup(&plan)
 	remoteGroup, err := r.isAllowedToChangeGroup(ctx, state.ID.ValueString())
 	addErr(&resp.Diagnostics, err, operationUpdate, TwingateGroup)
@@ -189,10 +212,17 @@ func (r *group) Delete(ctx context.Context, req resource.DeleteRequest, resp *re
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if isDeletionProtected(state.DeletionProtection, &resp.Diagnostics, TwingateGroup) {
+		return
+	}
+
 	if _, err := r.isAllowedToChangeGroup(ctx, state.ID.ValueString()); err != nil {
 		addErr(&resp.Diagnostics, err, operationDelete, TwingateGroup)
 
@@ -237,6 +267,7 @@ func (r *group) helper(ctx context.Context, group *model.Group, state *groupMode
 	state.ID = types.StringValue(group.ID)
 	state.Name = types.StringValue(group.Name)
 	state.IsAuthoritative = types.BoolValue(group.IsAuthoritative)
+	state.DeletionProtection = deletionProtectionValue(state.DeletionProtection)
 
 	if !state.UserIDs.IsNull() {
 		userIDs, diags := types.SetValueFrom(ctx, types.StringType, group.Users)
@@ -2,15 +2,22 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+var ErrDeletionProtectionEnabled = errors.New("deletion_protection is enabled: set `deletion_protection = false` and apply the change before destroying this resource")
+
 // setIntersection - for given two sets A and B,
 // A ∩ B (read as A intersection B) is the set of common elements that belong to set A and B.
 // If A = {1, 2, 3, 4} and B = {3, 4, 5, 7}, then the intersection of A and B is given by A ∩ B = {3, 4}.
@@ -130,6 +137,61 @@ func addErr(diagnostics *diag.Diagnostics, err error, operation, resource string
 	)
 }
 
+func deletionProtectionAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Prevents the resource from being destroyed while set to `true`. It must be applied as `false` before the resource can be deleted. Default is `false`.",
+		Default:     booldefault.StaticBool(false),
+	}
+}
+
+// isDeletionProtected adds a delete error to diagnostics when the deletion_protection flag is set in state.
+func isDeletionProtected(deletionProtection types.Bool, diagnostics *diag.Diagnostics, resource string) bool {
+	if !deletionProtection.ValueBool() {
+		return false
+	}
+
+	addErr(diagnostics, ErrDeletionProtectionEnabled, operationDelete, resource)
+
+	return true
+}
+
+// deletionProtectionValue falls back to the schema default for state that has no
+// deletion_protection value yet (imported resources or state written by older provider versions).
+func deletionProtectionValue(val types.Bool) types.Bool {
+	if val.IsNull() || val.IsUnknown() {
+		return types.BoolValue(false)
+	}
+
+	return val
+}
+
+// timeoutsBlock defines the optional create, read, update and delete timeouts of a resource.
+func timeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.BlockAll(ctx)
+}
+
+// nullTimeouts is the value of an unset timeouts block, used when state is built from scratch.
+func nullTimeouts(ctx context.Context) timeouts.Value {
+	blockType, _ := timeoutsBlock(ctx).Type().(timeouts.Type)
+
+	return timeouts.Value{Object: types.ObjectNull(blockType.AttrTypes)}
+}
+
+// withTimeout bounds ctx by the timeout configured for the operation in the `timeouts` block.
+// Without one, ctx is returned unchanged and the client's per-request defaults apply.
+func withTimeout(ctx context.Context, timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics), diagnostics *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	duration, diags := timeout(ctx, 0)
+	diagnostics.Append(diags...)
+
+	if duration <= 0 {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, duration)
+}
+
 func makeNullObject(attributeTypes map[string]tfattr.Type) types.Object {
 	return types.ObjectNull(attributeTypes)
 }
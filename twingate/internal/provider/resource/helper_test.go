@@ -1,12 +1,17 @@
 package resource
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -229,3 +234,99 @@ func TestValidateBypassRoutingMode(t *testing.T) {
 		})
 	}
 }
+
+func TestIsDeletionProtected(t *testing.T) {
+	cases := []struct {
+		input       types.Bool
+		expected    bool
+		expectedErr bool
+	}{
+		{
+			input:    types.BoolNull(),
+			expected: false,
+		},
+		{
+			input:    types.BoolValue(false),
+			expected: false,
+		},
+		{
+			input:       types.BoolValue(true),
+			expected:    true,
+			expectedErr: true,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			var diagnostics diag.Diagnostics
+
+			actual := isDeletionProtected(c.input, &diagnostics, TwingateRemoteNetwork)
+
+			assert.Equal(t, c.expected, actual)
+			assert.Equal(t, c.expectedErr, diagnostics.HasError())
+		})
+	}
+}
+
+func TestDeletionProtectionValue(t *testing.T) {
+	cases := []struct {
+		input    types.Bool
+		expected types.Bool
+	}{
+		{
+			input:    types.BoolNull(),
+			expected: types.BoolValue(false),
+		},
+		{
+			input:    types.BoolUnknown(),
+			expected: types.BoolValue(false),
+		},
+		{
+			input:    types.BoolValue(true),
+			expected: types.BoolValue(true),
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, deletionProtectionValue(c.input))
+		})
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	ctx := context.Background()
+	attrTypes := nullTimeouts(ctx).AttributeTypes(ctx)
+
+	t.Run("not configured", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		timeoutCtx, cancel := withTimeout(ctx, nullTimeouts(ctx).Create, &diags)
+		defer cancel()
+
+		_, ok := timeoutCtx.Deadline()
+
+		assert.False(t, diags.HasError())
+		assert.False(t, ok)
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		value := timeouts.Value{Object: types.ObjectValueMust(attrTypes, map[string]tfattr.Value{
+			"create": types.StringValue("30m"),
+			"read":   types.StringNull(),
+			"update": types.StringNull(),
+			"delete": types.StringNull(),
+		})}
+
+		timeoutCtx, cancel := withTimeout(ctx, value.Create, &diags)
+		defer cancel()
+
+		deadline, ok := timeoutCtx.Deadline()
+
+		assert.False(t, diags.HasError())
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(30*time.Minute), deadline, time.Second)
+	})
+}
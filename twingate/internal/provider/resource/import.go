@@ -0,0 +1,206 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+)
+
+const (
+	importKeyNamePrefix  = "name:"
+	importKeyEmailPrefix = "email:"
+	importKeySeparator   = "/"
+)
+
+var (
+	ErrImportKeyNotFound  = errors.New("nothing matches the import key")
+	ErrImportKeyAmbiguous = errors.New("more than one object matches the import key, import by ID instead")
+)
+
+// parseImportKey returns the value after prefix, if the import ID starts with it.
+func parseImportKey(importID, prefix string) (string, bool) {
+	value, ok := strings.CutPrefix(importID, prefix)
+	if !ok || value == "" {
+		return "", false
+	}
+
+	return value, true
+}
+
+// parseResourceImportKey splits a `<remote network name>/<resource name or address>` import ID at
+// the first `/`, see resolveResourceImportID for names containing one.
+func parseResourceImportKey(importID string) (string, string, bool) {
+	network, key, ok := strings.Cut(importID, importKeySeparator)
+	if !ok || network == "" || key == "" {
+		return "", "", false
+	}
+
+	return network, key, true
+}
+
+// singleMatch turns a list of matching IDs into the one ID the import key refers to.
+func singleMatch(importID string, ids []string) (string, error) {
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("%w: %q", ErrImportKeyNotFound, importID)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%w: %q matches %s", ErrImportKeyAmbiguous, importID, strings.Join(ids, ", "))
+	}
+}
+
+// resolveRemoteNetworkImportID accepts `name:<remote network name>` besides the Twingate ID.
+func resolveRemoteNetworkImportID(ctx context.Context, apiClient *client.Client, importID string) (string, error) {
+	name, ok := parseImportKey(importID, importKeyNamePrefix)
+	if !ok {
+		return importID, nil
+	}
+
+	networks, err := apiClient.ReadRemoteNetworks(ctx, name, "")
+	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		return "", err
+	}
+
+	var ids []string
+
+	for _, network := range networks {
+		if network.Name == name {
+			ids = append(ids, network.ID)
+		}
+	}
+
+	return singleMatch(importID, ids)
+}
+
+// resolveGroupImportID accepts `name:<group name>` besides the Twingate ID.
+func resolveGroupImportID(ctx context.Context, apiClient *client.Client, importID string) (string, error) {
+	name, ok := parseImportKey(importID, importKeyNamePrefix)
+	if !ok {
+		return importID, nil
+	}
+
+	groups, err := apiClient.ReadGroups(ctx, &model.GroupsFilter{Name: &name})
+	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		return "", err
+	}
+
+	var ids []string
+
+	for _, group := range groups {
+		if group.Name == name {
+			ids = append(ids, group.ID)
+		}
+	}
+
+	return singleMatch(importID, ids)
+}
+
+// resolveUserImportID accepts `email:<user email>` besides the Twingate ID.
+func resolveUserImportID(ctx context.Context, apiClient *client.Client, importID string) (string, error) {
+	email, ok := parseImportKey(importID, importKeyEmailPrefix)
+	if !ok {
+		return importID, nil
+	}
+
+	users, err := apiClient.ReadUsers(ctx, &client.UsersFilter{Email: &client.StringFilter{Name: email}})
+	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		return "", err
+	}
+
+	var ids []string
+
+	for _, user := range users {
+		if strings.EqualFold(user.Email, email) {
+			ids = append(ids, user.ID)
+		}
+	}
+
+	return singleMatch(importID, ids)
+}
+
+// resourceImportNetwork is a Remote Network name that starts a resource import ID, followed by a
+// `/` and the key of the resource.
+type resourceImportNetwork struct {
+	name string
+	ids  []string
+	key  string
+}
+
+// matchResourceImportNetworks returns the Remote Network names that start the import ID, shortest
+// first. Networks sharing a name are grouped, so an ambiguous name can be rejected.
+func matchResourceImportNetworks(importID string, networks []*model.RemoteNetwork) []resourceImportNetwork {
+	var matches []resourceImportNetwork
+
+	for _, network := range networks {
+		key, ok := strings.CutPrefix(importID, network.Name+importKeySeparator)
+		if !ok || network.Name == "" || key == "" {
+			continue
+		}
+
+		idx := slices.IndexFunc(matches, func(match resourceImportNetwork) bool {
+			return match.name == network.Name
+		})
+		if idx == -1 {
+			matches = append(matches, resourceImportNetwork{name: network.Name, key: key})
+			idx = len(matches) - 1
+		}
+
+		matches[idx].ids = append(matches[idx].ids, network.ID)
+	}
+
+	slices.SortFunc(matches, func(a, b resourceImportNetwork) int {
+		return len(a.name) - len(b.name)
+	})
+
+	return matches
+}
+
+// resolveResourceImportID accepts `<remote network name>/<resource name>` and
+// `<remote network name>/<address>` besides the Twingate ID. Network names and addresses may both
+// contain a `/`, so every Remote Network whose name starts the import ID is searched. Base64 IDs
+// may contain a `/` as well, so the import ID is used as is when no Remote Network matches.
+func resolveResourceImportID(ctx context.Context, apiClient *client.Client, importID string) (string, error) {
+	prefix, _, ok := parseResourceImportKey(importID)
+	if !ok {
+		return importID, nil
+	}
+
+	networks, err := apiClient.ReadRemoteNetworks(ctx, prefix, attr.FilterByPrefix)
+	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		return "", err
+	}
+
+	candidates := matchResourceImportNetworks(importID, networks)
+	if len(candidates) == 0 {
+		return importID, nil
+	}
+
+	var ids []string
+
+	for _, candidate := range candidates {
+		networkID, err := singleMatch(candidate.name, candidate.ids)
+		if err != nil {
+			return "", err
+		}
+
+		resources, err := apiClient.ReadResourcesByName(ctx, &model.ResourcesFilter{RemoteNetworkID: &networkID})
+		if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+			return "", err
+		}
+
+		for _, res := range resources {
+			if res.ID != "" && (res.Name == candidate.key || res.Address == candidate.key) {
+				ids = append(ids, res.ID)
+			}
+		}
+	}
+
+	return singleMatch(importID, ids)
+}
@@ -0,0 +1,113 @@
+package resource
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImportKey(t *testing.T) {
+	cases := []struct {
+		importID      string
+		prefix        string
+		expectedValue string
+		expectedOK    bool
+	}{
+		{importID: "name:Engineering", prefix: importKeyNamePrefix, expectedValue: "Engineering", expectedOK: true},
+		{importID: "email:user@example.com", prefix: importKeyEmailPrefix, expectedValue: "user@example.com", expectedOK: true},
+		{importID: "name:", prefix: importKeyNamePrefix},
+		{importID: "R3JvdXA6MTIz", prefix: importKeyNamePrefix},
+		{importID: "email:user@example.com", prefix: importKeyNamePrefix},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			value, ok := parseImportKey(c.importID, c.prefix)
+
+			assert.Equal(t, c.expectedOK, ok)
+			assert.Equal(t, c.expectedValue, value)
+		})
+	}
+}
+
+func TestParseResourceImportKey(t *testing.T) {
+	cases := []struct {
+		importID        string
+		expectedNetwork string
+		expectedKey     string
+		expectedOK      bool
+	}{
+		{importID: "AWS/web", expectedNetwork: "AWS", expectedKey: "web", expectedOK: true},
+		{importID: "AWS/10.0.0.0/16", expectedNetwork: "AWS", expectedKey: "10.0.0.0/16", expectedOK: true},
+		{importID: "UmVzb3VyY2U6MTIz"},
+		{importID: "/web"},
+		{importID: "AWS/"},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			network, key, ok := parseResourceImportKey(c.importID)
+
+			assert.Equal(t, c.expectedOK, ok)
+			assert.Equal(t, c.expectedNetwork, network)
+			assert.Equal(t, c.expectedKey, key)
+		})
+	}
+}
+
+func TestSingleMatch(t *testing.T) {
+	cases := []struct {
+		ids         []string
+		expected    string
+		expectedErr error
+	}{
+		{ids: nil, expectedErr: ErrImportKeyNotFound},
+		{ids: []string{"id-1"}, expected: "id-1"},
+		{ids: []string{"id-1", "id-2"}, expectedErr: ErrImportKeyAmbiguous},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			id, err := singleMatch("name:test", c.ids)
+
+			assert.ErrorIs(t, err, c.expectedErr)
+			assert.Equal(t, c.expected, id)
+		})
+	}
+}
+
+func TestMatchResourceImportNetworks(t *testing.T) {
+	networks := []*model.RemoteNetwork{
+		{ID: "rn-1", Name: "AWS"},
+		{ID: "rn-2", Name: "AWS/us-east-1"},
+		{ID: "rn-3", Name: "AWS/us-east-1"},
+		{ID: "rn-4", Name: "AWS-legacy"},
+	}
+
+	cases := []struct {
+		importID string
+		expected []resourceImportNetwork
+	}{
+		{
+			importID: "AWS/10.0.0.0/16",
+			expected: []resourceImportNetwork{{name: "AWS", ids: []string{"rn-1"}, key: "10.0.0.0/16"}},
+		},
+		{
+			importID: "AWS/us-east-1/web",
+			expected: []resourceImportNetwork{
+				{name: "AWS", ids: []string{"rn-1"}, key: "us-east-1/web"},
+				{name: "AWS/us-east-1", ids: []string{"rn-2", "rn-3"}, key: "web"},
+			},
+		},
+		{importID: "AWS/"},
+		{importID: "GCP/web"},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, matchResourceImportNetworks(c.importID, networks))
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -45,21 +46,22 @@ type kubernetesResource struct {
 }
 
 type kubernetesResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	Address          types.String `tfsdk:"address"`
-	BearerTokenFile  types.String `tfsdk:"bearer_token_file"`
-	CAFile           types.String `tfsdk:"ca_file"`
-	GatewayID        types.String `tfsdk:"gateway_id"`
-	RemoteNetworkID  types.String `tfsdk:"remote_network_id"`
-	InCluster        types.Bool   `tfsdk:"in_cluster"`
-	IsVisible        types.Bool   `tfsdk:"is_visible"`
-	Alias            types.String `tfsdk:"alias"`
-	SecurityPolicyID types.String `tfsdk:"security_policy_id"`
-	Tags             types.Map    `tfsdk:"tags"`
-	Protocols        types.Object `tfsdk:"protocols"`
-	AccessPolicy     types.Set    `tfsdk:"access_policy"`
-	GroupAccess      types.Set    `tfsdk:"access_group"`
+	ID               types.String   `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	Address          types.String   `tfsdk:"address"`
+	BearerTokenFile  types.String   `tfsdk:"bearer_token_file"`
+	CAFile           types.String   `tfsdk:"ca_file"`
+	GatewayID        types.String   `tfsdk:"gateway_id"`
+	RemoteNetworkID  types.String   `tfsdk:"remote_network_id"`
+	InCluster        types.Bool     `tfsdk:"in_cluster"`
+	IsVisible        types.Bool     `tfsdk:"is_visible"`
+	Alias            types.String   `tfsdk:"alias"`
+	SecurityPolicyID types.String   `tfsdk:"security_policy_id"`
+	Tags             types.Map      `tfsdk:"tags"`
+	Protocols        types.Object   `tfsdk:"protocols"`
+	AccessPolicy     types.Set      `tfsdk:"access_policy"`
+	GroupAccess      types.Set      `tfsdk:"access_group"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *kubernetesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -84,7 +86,7 @@ func (r *kubernetesResource) ImportState(ctx context.Context, req resource.Impor
 }
 
 //nolint:funlen
-func (r *kubernetesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *kubernetesResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Kubernetes Resources are Twingate resources accessed via a Gateway.",
 		Attributes: map[string]schema.Attribute{
@@ -166,6 +168,7 @@ func (r *kubernetesResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			attr.Protocols: protocols(),
 		},
 		Blocks: map[string]schema.Block{
+			attr.Timeouts:     timeoutsBlock(ctx),
 			attr.AccessPolicy: accessPolicyBlock(),
 			attr.AccessGroup:  groupAccessBlock(),
 		},
@@ -178,6 +181,9 @@ func (r *kubernetesResource) Create(ctx context.Context, req resource.CreateRequ
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -250,6 +256,9 @@ func (r *kubernetesResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -265,6 +274,9 @@ func (r *kubernetesResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	if !plan.InCluster.IsNull() && !plan.InCluster.IsUnknown() && !plan.InCluster.ValueBool() {
 		if plan.BearerTokenFile.ValueString() == "" {
 			addErr(&resp.Diagnostics, ErrBearerTokenFileEmpty, operationUpdate, TwingateKubernetesResource)
@@ -375,6 +387,9 @@ func (r *kubernetesResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
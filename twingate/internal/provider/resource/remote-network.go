@@ -10,11 +10,13 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -23,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+var ErrRemoteNetworkHasDependencies = errors.New("the Remote Network still has attached objects, remove them first or set `force_destroy = true`")
+
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.Resource = &remoteNetwork{}
 
@@ -35,10 +39,53 @@ type remoteNetwork struct {
 }
 
 type remoteNetworkModel struct {
-	ID       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	Location types.String `tfsdk:"location"`
-	Type     types.String `tfsdk:"type"`
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Location           types.String   `tfsdk:"location"`
+	Type               types.String   `tfsdk:"type"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	ForceDestroy       types.Bool     `tfsdk:"force_destroy"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// remoteNetworkDependencies holds the objects attached to a Remote Network that block its deletion.
+type remoteNetworkDependencies struct {
+	resources           []*model.Resource
+	sshResources        []*model.SSHResource
+	kubernetesResources []*model.KubernetesResource
+	gateways            []*model.Gateway
+	connectors          []*model.Connector
+}
+
+func (d *remoteNetworkDependencies) isEmpty() bool {
+	return len(d.resources) == 0 && len(d.sshResources) == 0 && len(d.kubernetesResources) == 0 &&
+		len(d.gateways) == 0 && len(d.connectors) == 0
+}
+
+func (d *remoteNetworkDependencies) String() string {
+	lines := make([]string, 0, len(d.resources)+len(d.sshResources)+len(d.kubernetesResources)+len(d.gateways)+len(d.connectors))
+
+	for _, res := range d.resources {
+		lines = append(lines, fmt.Sprintf("- %s %q (%s)", TwingateResource, res.Name, res.ID))
+	}
+
+	for _, res := range d.sshResources {
+		lines = append(lines, fmt.Sprintf("- %s %q (%s)", TwingateSSHResource, res.Name, res.ID))
+	}
+
+	for _, res := range d.kubernetesResources {
+		lines = append(lines, fmt.Sprintf("- %s %q (%s)", TwingateKubernetesResource, res.Name, res.ID))
+	}
+
+	for _, gw := range d.gateways {
+		lines = append(lines, fmt.Sprintf("- %s %q (%s)", TwingateGateway, gw.Address, gw.ID))
+	}
+
+	for _, conn := range d.connectors {
+		lines = append(lines, fmt.Sprintf("- %s %q (%s)", TwingateConnector, conn.Name, conn.ID))
+	}
+
+	return strings.Join(lines, "\n")
 }
 
 func (r *remoteNetwork) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -59,10 +106,17 @@ func (r *remoteNetwork) Configure(_ context.Context, req resource.ConfigureReque
 }
 
 func (r *remoteNetwork) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root(attr.ID), req, resp)
+	id, err := resolveRemoteNetworkImportID(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to import state", err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.ID), id)...)
 }
 
-func (r *remoteNetwork) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *remoteNetwork) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "A Remote Network represents a single private network in Twingate that can have one or more Connectors and Resources assigned to it. You must create a Remote Network before creating Resources and Connectors that belong to it. For more information, see Twingate's [documentation](https://docs.twingate.com/docs/remote-networks).",
 		Attributes: map[string]schema.Attribute{
@@ -90,6 +144,13 @@ func (r *remoteNetwork) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringvalidator.OneOf(model.NetworkTypeRegular, model.NetworkTypeExit),
 				},
 			},
+			attr.DeletionProtection: deletionProtectionAttribute(),
+			attr.ForceDestroy: schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "When set to `true`, destroying the Remote Network also deletes its Resources, SSH and Kubernetes Resources, Gateways and Connectors. Otherwise the destroy fails while any of them are still attached. Default is `false`.",
+				Default:     booldefault.StaticBool(false),
+			},
 			// computed
 			attr.ID: schema.StringAttribute{
 				Computed:      true,
@@ -97,6 +158,9 @@ func (r *remoteNetwork) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			attr.Timeouts: timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -105,6 +169,9 @@ func (r *remoteNetwork) Create(ctx context.Context, req resource.CreateRequest,
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -123,6 +190,9 @@ func (r *remoteNetwork) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -138,6 +208,9 @@ func (r *remoteNetwork) Update(ctx context.Context, req resource.UpdateRequest,
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -163,14 +236,120 @@ func (r *remoteNetwork) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := r.client.DeleteRemoteNetwork(ctx, state.ID.ValueString())
+	if isDeletionProtected(state.DeletionProtection, &resp.Diagnostics, TwingateRemoteNetwork) {
+		return
+	}
+
+	dependencies, err := r.readDependencies(ctx, state.ID.ValueString())
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationDelete, TwingateRemoteNetwork)
+
+		return
+	}
+
+	if !dependencies.isEmpty() {
+		if !state.ForceDestroy.ValueBool() {
+			addErr(&resp.Diagnostics, fmt.Errorf("%w:\n%s", ErrRemoteNetworkHasDependencies, dependencies), operationDelete, TwingateRemoteNetwork)
+
+			return
+		}
+
+		if err := r.deleteDependencies(ctx, dependencies); err != nil {
+			addErr(&resp.Diagnostics, err, operationDelete, TwingateRemoteNetwork)
+
+			return
+		}
+	}
+
+	err = r.client.DeleteRemoteNetwork(ctx, state.ID.ValueString())
 	addErr(&resp.Diagnostics, err, operationDelete, TwingateRemoteNetwork)
 }
 
+// readDependencies collects the Resources, Gateways and Connectors attached to the Remote Network.
+// Resources and Connectors are filtered by Remote Network by the API, Gateways can't be, so they are
+// all listed and filtered here.
+func (r *remoteNetwork) readDependencies(ctx context.Context, remoteNetworkID string) (*remoteNetworkDependencies, error) {
+	var dependencies remoteNetworkDependencies
+
+	resources, err := r.client.ReadResourcesByName(ctx, &model.ResourcesFilter{RemoteNetworkID: &remoteNetworkID})
+	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		return nil, fmt.Errorf("failed to read resources: %w", err)
+	}
+
+	for _, res := range resources {
+		// non-network resources (SSH, Kubernetes) come back without fields
+		if res.ID != "" && res.RemoteNetworkID == remoteNetworkID {
+			dependencies.resources = append(dependencies.resources, res)
+		}
+	}
+
+	dependencies.sshResources, dependencies.kubernetesResources, err = r.client.ReadRemoteNetworkGatewayResources(ctx, remoteNetworkID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SSH and Kubernetes resources: %w", err)
+	}
+
+	gateways, err := r.client.ReadGateways(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read gateways: %w", err)
+	}
+
+	for _, gw := range gateways {
+		if gw.RemoteNetworkID == remoteNetworkID {
+			dependencies.gateways = append(dependencies.gateways, gw)
+		}
+	}
+
+	dependencies.connectors, err = r.client.ReadRemoteNetworkConnectors(ctx, remoteNetworkID)
+	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		return nil, fmt.Errorf("failed to read connectors: %w", err)
+	}
+
+	return &dependencies, nil
+}
+
+// deleteDependencies removes the attached objects in dependency order: Resources first, then the
+// Gateways the SSH and Kubernetes Resources route through, and the Connectors last.
+func (r *remoteNetwork) deleteDependencies(ctx context.Context, dependencies *remoteNetworkDependencies) error {
+	for _, res := range dependencies.resources {
+		if err := r.client.DeleteResource(ctx, res.ID); err != nil {
+			return fmt.Errorf("failed to delete resource %s: %w", res.ID, err)
+		}
+	}
+
+	for _, res := range dependencies.sshResources {
+		if err := r.client.DeleteSSHResource(ctx, res.ID); err != nil {
+			return fmt.Errorf("failed to delete SSH resource %s: %w", res.ID, err)
+		}
+	}
+
+	for _, res := range dependencies.kubernetesResources {
+		if err := r.client.DeleteKubernetesResource(ctx, res.ID); err != nil {
+			return fmt.Errorf("failed to delete Kubernetes resource %s: %w", res.ID, err)
+		}
+	}
+
+	for _, gw := range dependencies.gateways {
+		if err := r.client.DeleteGateway(ctx, gw.ID); err != nil {
+			return fmt.Errorf("failed to delete gateway %s: %w", gw.ID, err)
+		}
+	}
+
+	for _, conn := range dependencies.connectors {
+		if err := r.client.DeleteConnector(ctx, conn.ID); err != nil {
+			return fmt.Errorf("failed to delete connector %s: %w", conn.ID, err)
+		}
+	}
+
+	return nil
+}
+
 func (r *remoteNetwork) helper(ctx context.Context, network *model.RemoteNetwork, state *remoteNetworkModel, respState *tfsdk.State, diagnostics *diag.Diagnostics, err error, operation string) {
 	if err != nil {
 		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
@@ -189,6 +368,11 @@ func (r *remoteNetwork) helper(ctx context.Context, network *model.RemoteNetwork
 	state.Name = types.StringValue(network.Name)
 	state.Location = types.StringValue(network.Location)
 	state.Type = types.StringValue(network.Type)
+	state.DeletionProtection = deletionProtectionValue(state.DeletionProtection)
+
+	if state.ForceDestroy.IsNull() || state.ForceDestroy.IsUnknown() {
+		state.ForceDestroy = types.BoolValue(false)
+	}
 
 	// Set refreshed state
 	diags := respState.Set(ctx, state)
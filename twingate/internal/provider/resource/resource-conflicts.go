@@ -0,0 +1,184 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	addressConflictDuplicate = "Duplicate Resource address"
+	addressConflictOverlap   = "Overlapping Resource address"
+	addressConflictShadowing = "Shadowed Resource address"
+)
+
+// addressConflict describes the existing Resources a planned address collides with.
+type addressConflict struct {
+	kind      string
+	resources []*model.Resource
+	detail    string
+}
+
+// checkAddressConflicts compares the planned address with the other Resources in the Remote Network.
+// It only runs when the address, Remote Network or protocols change, so unchanged Resources don't cost an API call.
+func (r *twingateResource) checkAddressConflicts(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || r.addressConflicts == providerdata.ConflictsIgnore {
+		return
+	}
+
+	var plan resourceModel
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		return
+	}
+
+	if plan.Address.IsUnknown() || plan.RemoteNetworkID.IsUnknown() || plan.Protocols.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state resourceModel
+		if diags := req.State.Get(ctx, &state); !diags.HasError() &&
+			state.Address.Equal(plan.Address) && state.RemoteNetworkID.Equal(plan.RemoteNetworkID) && state.Protocols.Equal(plan.Protocols) {
+			return
+		}
+	}
+
+	protocols, err := convertProtocols(&plan.Protocols)
+	if err != nil {
+		// invalid protocols are reported on apply
+		return
+	}
+
+	existing, err := r.client.ReadRemoteNetworkResources(ctx, plan.RemoteNetworkID.ValueString())
+	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		resp.Diagnostics.AddWarning("Couldn't check the Resource address for conflicts", err.Error())
+
+		return
+	}
+
+	planned := &model.Resource{
+		ID:        plan.ID.ValueString(),
+		Address:   plan.Address.ValueString(),
+		Protocols: protocols,
+	}
+
+	for _, conflict := range findAddressConflicts(planned, existing) {
+		ids := utils.Map(conflict.resources, func(res *model.Resource) string {
+			return fmt.Sprintf("%s (%s)", res.ID, res.Address)
+		})
+
+		detail := fmt.Sprintf("Address %q %s in the same Remote Network: %s.", planned.Address, conflict.detail, strings.Join(ids, ", "))
+
+		if r.addressConflicts == providerdata.ConflictsError {
+			resp.Diagnostics.AddAttributeError(path.Root(attr.Address), conflict.kind, detail)
+		} else {
+			resp.Diagnostics.AddAttributeWarning(path.Root(attr.Address), conflict.kind, detail)
+		}
+	}
+}
+
+// findAddressConflicts returns the Resources whose address duplicates, overlaps or shadows the planned one.
+func findAddressConflicts(planned *model.Resource, existing []*model.Resource) []addressConflict {
+	var duplicates, conflictingDuplicates, overlaps, shadowing []*model.Resource
+
+	plannedPrefix, plannedIsPrefix := utils.ParsePrefix(planned.Address)
+
+	for _, res := range existing {
+		// inactive Resources don't route traffic, so they can't conflict
+		if res.ID == planned.ID && planned.ID != "" || !res.IsActive {
+			continue
+		}
+
+		switch {
+		case strings.EqualFold(res.Address, planned.Address):
+			if protocolsSummary(res.Protocols) == protocolsSummary(planned.Protocols) {
+				duplicates = append(duplicates, res)
+			} else {
+				conflictingDuplicates = append(conflictingDuplicates, res)
+			}
+
+		case plannedIsPrefix:
+			if prefix, ok := utils.ParsePrefix(res.Address); ok && prefix.Overlaps(plannedPrefix) {
+				overlaps = append(overlaps, res)
+			}
+
+		case isWildcard(planned.Address) && utils.MatchWildcard(planned.Address, res.Address),
+			isWildcard(res.Address) && utils.MatchWildcard(res.Address, planned.Address):
+			shadowing = append(shadowing, res)
+		}
+	}
+
+	var conflicts []addressConflict
+
+	if len(conflictingDuplicates) > 0 {
+		conflicts = append(conflicts, addressConflict{
+			kind:      addressConflictDuplicate,
+			resources: conflictingDuplicates,
+			detail:    fmt.Sprintf("with protocols %s is already used with different protocols", protocolsSummary(planned.Protocols)),
+		})
+	}
+
+	if len(duplicates) > 0 {
+		conflicts = append(conflicts, addressConflict{kind: addressConflictDuplicate, resources: duplicates, detail: "is already used"})
+	}
+
+	if len(overlaps) > 0 {
+		conflicts = append(conflicts, addressConflict{kind: addressConflictOverlap, resources: overlaps, detail: "overlaps"})
+	}
+
+	if len(shadowing) > 0 {
+		conflicts = append(conflicts, addressConflict{kind: addressConflictShadowing, resources: shadowing, detail: "shadows or is shadowed by the wildcard addresses"})
+	}
+
+	return conflicts
+}
+
+func isWildcard(address string) bool {
+	return strings.ContainsAny(address, "*?")
+}
+
+// protocolsSummary renders the protocols in a canonical form, e.g. `icmp, tcp RESTRICTED 22 443, udp ALLOW_ALL`.
+func protocolsSummary(protocols *model.Protocols) string {
+	if protocols == nil {
+		protocols = model.DefaultProtocols()
+	}
+
+	parts := make([]string, 0, 3) //nolint:mnd
+	if protocols.AllowIcmp {
+		parts = append(parts, "icmp")
+	}
+
+	parts = append(parts, "tcp "+protocolSummary(protocols.TCP), "udp "+protocolSummary(protocols.UDP))
+
+	return strings.Join(parts, ", ")
+}
+
+func protocolSummary(protocol *model.Protocol) string {
+	if protocol == nil {
+		protocol = model.DefaultProtocol()
+	}
+
+	policy := protocol.Policy
+	if policy == model.PolicyRestricted && len(protocol.Ports) == 0 {
+		policy = model.PolicyDenyAll
+	}
+
+	ports := protocol.PortsToString()
+	if len(ports) == 0 {
+		return policy
+	}
+
+	slices.Sort(ports)
+
+	return policy + " " + strings.Join(ports, " ")
+}
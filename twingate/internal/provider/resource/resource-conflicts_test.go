@@ -0,0 +1,100 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindAddressConflicts(t *testing.T) {
+	sshOnly := &model.Protocols{
+		TCP: model.NewProtocol(model.PolicyRestricted, []*model.PortRange{{Start: 22, End: 22}}),
+		UDP: model.NewProtocol(model.PolicyDenyAll, nil),
+	}
+
+	existing := []*model.Resource{
+		{ID: "self", Address: "10.0.0.0/24", IsActive: true},
+		{ID: "dup", Address: "DB.example.com", IsActive: true},
+		{ID: "dup-ssh", Address: "db.example.com", Protocols: sshOnly, IsActive: true},
+		{ID: "subnet", Address: "10.0.0.128/25", IsActive: true},
+		{ID: "ip", Address: "10.0.1.5", IsActive: true},
+		{ID: "wildcard", Address: "*.example.com", IsActive: true},
+		{ID: "host", Address: "api.internal", IsActive: true},
+		{ID: "inactive", Address: "10.0.0.64/26"},
+		{ID: "inactive-host", Address: "db.example.com"},
+	}
+
+	cases := []struct {
+		name     string
+		planned  *model.Resource
+		expected map[string][]string
+	}{
+		{
+			name:    "own address is ignored on update",
+			planned: &model.Resource{ID: "self", Address: "10.0.0.0/24"},
+			expected: map[string][]string{
+				addressConflictOverlap: {"subnet"},
+			},
+		},
+		{
+			name:    "cidr overlap",
+			planned: &model.Resource{Address: "10.0.0.0/16"},
+			expected: map[string][]string{
+				addressConflictOverlap: {"self", "subnet", "ip"},
+			},
+		},
+		{
+			name:    "exact duplicate with equal and different protocols",
+			planned: &model.Resource{Address: "db.example.com", Protocols: model.DefaultProtocols()},
+			expected: map[string][]string{
+				addressConflictDuplicate: {"dup-ssh", "dup"},
+				addressConflictShadowing: {"wildcard"},
+			},
+		},
+		{
+			name:    "planned wildcard shadows a host",
+			planned: &model.Resource{Address: "api.*"},
+			expected: map[string][]string{
+				addressConflictShadowing: {"host"},
+			},
+		},
+		{
+			name:     "no conflicts",
+			planned:  &model.Resource{Address: "192.168.0.0/24"},
+			expected: map[string][]string{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := make(map[string][]string)
+
+			for _, conflict := range findAddressConflicts(c.planned, existing) {
+				for _, res := range conflict.resources {
+					actual[conflict.kind] = append(actual[conflict.kind], res.ID)
+				}
+			}
+
+			assert.Equal(t, c.expected, actual)
+		})
+	}
+}
+
+func TestProtocolsSummary(t *testing.T) {
+	restrictedWithoutPorts := &model.Protocols{
+		TCP:       model.NewProtocol(model.PolicyRestricted, nil),
+		UDP:       model.DefaultProtocol(),
+		AllowIcmp: true,
+	}
+
+	assert.Equal(t, "icmp, tcp ALLOW_ALL, udp ALLOW_ALL", protocolsSummary(nil))
+	assert.Equal(t, "icmp, tcp DENY_ALL, udp ALLOW_ALL", protocolsSummary(restrictedWithoutPorts))
+
+	ports := &model.Protocols{
+		TCP: model.NewProtocol(model.PolicyRestricted, []*model.PortRange{{Start: 443, End: 443}, {Start: 22, End: 22}}),
+		UDP: model.NewProtocol(model.PolicyDenyAll, nil),
+	}
+
+	assert.Equal(t, "tcp RESTRICTED 22 443, udp DENY_ALL", protocolsSummary(ports))
+}
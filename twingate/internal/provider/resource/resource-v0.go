@@ -203,6 +203,7 @@ func upgradeResourceStateV0() resource.StateUpgrader {
 				ServiceAccess: serviceAccess,
 				Tags:          types.MapNull(types.StringType),
 				TagsAll:       types.MapNull(types.StringType),
+				Timeouts:      nullTimeouts(ctx),
 			}
 
 			if !priorState.IsAuthoritative.IsNull() {
@@ -74,6 +74,7 @@ func TestStateUpgraderV0(t *testing.T) {
 					ServiceAccess: makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:          types.MapNull(types.StringType),
 					TagsAll:       types.MapNull(types.StringType),
+					Timeouts:      nullTimeouts(context.TODO()),
 				}
 			},
 		},
@@ -114,6 +115,7 @@ func TestStateUpgraderV0(t *testing.T) {
 					ServiceAccess: makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:          types.MapNull(types.StringType),
 					TagsAll:       types.MapNull(types.StringType),
+					Timeouts:      nullTimeouts(context.TODO()),
 				}
 			},
 		},
@@ -156,6 +158,7 @@ func TestStateUpgraderV0(t *testing.T) {
 					ServiceAccess: makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:          types.MapNull(types.StringType),
 					TagsAll:       types.MapNull(types.StringType),
+					Timeouts:      nullTimeouts(context.TODO()),
 				}
 			},
 		},
@@ -223,6 +226,7 @@ func TestStateUpgraderV0(t *testing.T) {
 					AccessPolicy: makeObjectsSetNull(ctx, accessPolicyAttributeTypes()),
 					Tags:         types.MapNull(types.StringType),
 					TagsAll:      types.MapNull(types.StringType),
+					Timeouts:     nullTimeouts(context.TODO()),
 				}
 			},
 		},
@@ -198,6 +198,7 @@ func upgradeResourceStateV1() resource.StateUpgrader {
 				IsActive:        priorState.IsActive,
 				Tags:            types.MapNull(types.StringType),
 				TagsAll:         types.MapNull(types.StringType),
+				Timeouts:        nullTimeouts(ctx),
 			}
 
 			if !priorState.IsAuthoritative.IsNull() {
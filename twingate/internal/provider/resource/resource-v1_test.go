@@ -57,6 +57,7 @@ func TestStateUpgraderV1(t *testing.T) {
 					ServiceAccess:            makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:                     types.MapNull(types.StringType),
 					TagsAll:                  types.MapNull(types.StringType),
+					Timeouts:                 nullTimeouts(context.TODO()),
 				}
 			},
 		},
@@ -91,6 +92,7 @@ func TestStateUpgraderV1(t *testing.T) {
 					ServiceAccess:            makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:                     types.MapNull(types.StringType),
 					TagsAll:                  types.MapNull(types.StringType),
+					Timeouts:                 nullTimeouts(context.TODO()),
 				}
 			},
 		},
@@ -127,6 +129,7 @@ func TestStateUpgraderV1(t *testing.T) {
 					ServiceAccess:            makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:                     types.MapNull(types.StringType),
 					TagsAll:                  types.MapNull(types.StringType),
+					Timeouts:                 nullTimeouts(context.TODO()),
 				}
 			},
 		},
@@ -188,6 +191,7 @@ func TestStateUpgraderV1(t *testing.T) {
 					ServiceAccess:            accessServiceAccount,
 					Tags:                     types.MapNull(types.StringType),
 					TagsAll:                  types.MapNull(types.StringType),
+					Timeouts:                 nullTimeouts(context.TODO()),
 				}
 			},
 		},
@@ -246,6 +246,7 @@ func upgradeResourceStateV2() resource.StateUpgrader {
 				IsBrowserShortcutEnabled: priorState.IsBrowserShortcutEnabled,
 				Tags:                     priorState.Tags,
 				TagsAll:                  priorState.TagsAll,
+				Timeouts:                 nullTimeouts(ctx),
 			}
 
 			resp.Diagnostics.Append(resp.State.Set(ctx, upgradedState)...)
@@ -64,6 +64,7 @@ func TestStateUpgraderV2(t *testing.T) {
 					ServiceAccess:            makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:                     types.MapNull(types.StringType),
 					TagsAll:                  types.MapNull(types.StringType),
+					Timeouts:                 nullTimeouts(context.TODO()),
 				}
 			},
 		},
@@ -109,6 +110,7 @@ func TestStateUpgraderV2(t *testing.T) {
 					ServiceAccess:            makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:                     types.MapNull(types.StringType),
 					TagsAll:                  types.MapNull(types.StringType),
+					Timeouts:                 nullTimeouts(context.TODO()),
 				}
 			},
 		},
@@ -166,6 +168,7 @@ func TestStateUpgraderV2(t *testing.T) {
 					ServiceAccess:            makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:                     types.MapNull(types.StringType),
 					TagsAll:                  types.MapNull(types.StringType),
+					Timeouts:                 nullTimeouts(context.TODO()),
 				}
 			},
 		},
@@ -225,6 +228,7 @@ func TestStateUpgraderV2(t *testing.T) {
 					ServiceAccess:            makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:                     types.MapNull(types.StringType),
 					TagsAll:                  types.MapNull(types.StringType),
+					Timeouts:                 nullTimeouts(context.TODO()),
 				}
 			},
 		},
@@ -309,6 +313,7 @@ func TestStateUpgraderV2(t *testing.T) {
 					ServiceAccess:            makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:                     types.MapNull(types.StringType),
 					TagsAll:                  types.MapNull(types.StringType),
+					Timeouts:                 nullTimeouts(context.TODO()),
 				}
 			},
 		},
@@ -388,6 +393,7 @@ func TestStateUpgraderV2(t *testing.T) {
 					ServiceAccess:            makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:                     types.MapNull(types.StringType),
 					TagsAll:                  types.MapNull(types.StringType),
+					Timeouts:                 nullTimeouts(context.TODO()),
 				}
 			},
 		},
@@ -466,6 +472,7 @@ func TestStateUpgraderV2(t *testing.T) {
 					ServiceAccess:            makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:                     types.MapNull(types.StringType),
 					TagsAll:                  types.MapNull(types.StringType),
+					Timeouts:                 nullTimeouts(context.TODO()),
 				}
 			},
 		},
@@ -293,6 +293,7 @@ func upgradeResourceStateV3() resource.StateUpgrader {
 				IsBrowserShortcutEnabled: priorState.IsBrowserShortcutEnabled,
 				Tags:                     priorState.Tags,
 				TagsAll:                  priorState.TagsAll,
+				Timeouts:                 nullTimeouts(ctx),
 			}
 
 			resp.Diagnostics.Append(resp.State.Set(ctx, upgradedState)...)
@@ -66,6 +66,7 @@ func TestStateUpgraderV3(t *testing.T) {
 					ServiceAccess:            makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:                     types.MapNull(types.StringType),
 					TagsAll:                  types.MapNull(types.StringType),
+					Timeouts:                 nullTimeouts(context.TODO()),
 				}
 			},
 			expectedWarning: true,
@@ -113,6 +114,7 @@ func TestStateUpgraderV3(t *testing.T) {
 					ServiceAccess:            makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:                     types.MapNull(types.StringType),
 					TagsAll:                  types.MapNull(types.StringType),
+					Timeouts:                 nullTimeouts(context.TODO()),
 				}
 			},
 			expectedWarning: true,
@@ -172,6 +174,7 @@ func TestStateUpgraderV3(t *testing.T) {
 					ServiceAccess:            makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:                     types.MapNull(types.StringType),
 					TagsAll:                  types.MapNull(types.StringType),
+					Timeouts:                 nullTimeouts(context.TODO()),
 				}
 			},
 			expectedWarning: true,
@@ -233,6 +236,7 @@ func TestStateUpgraderV3(t *testing.T) {
 					ServiceAccess:            makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:                     types.MapNull(types.StringType),
 					TagsAll:                  types.MapNull(types.StringType),
+					Timeouts:                 nullTimeouts(context.TODO()),
 				}
 			},
 			expectedWarning: true,
@@ -319,6 +323,7 @@ func TestStateUpgraderV3(t *testing.T) {
 					ServiceAccess:            makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:                     types.MapNull(types.StringType),
 					TagsAll:                  types.MapNull(types.StringType),
+					Timeouts:                 nullTimeouts(context.TODO()),
 				}
 			},
 			expectedWarning: true,
@@ -399,6 +404,7 @@ func TestStateUpgraderV3(t *testing.T) {
 					ServiceAccess:            makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:                     types.MapNull(types.StringType),
 					TagsAll:                  types.MapNull(types.StringType),
+					Timeouts:                 nullTimeouts(context.TODO()),
 				}
 			},
 			expectedWarning: true,
@@ -497,6 +503,7 @@ func TestStateUpgraderV3(t *testing.T) {
 					ServiceAccess:            makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:                     types.MapNull(types.StringType),
 					TagsAll:                  types.MapNull(types.StringType),
+					Timeouts:                 nullTimeouts(context.TODO()),
 				}
 			},
 			expectedWarning: false,
@@ -587,6 +594,7 @@ func TestStateUpgraderV3(t *testing.T) {
 					ServiceAccess:            makeObjectsSetNull(ctx, accessServiceAccountAttributeTypes()),
 					Tags:                     types.MapNull(types.StringType),
 					TagsAll:                  types.MapNull(types.StringType),
+					Timeouts:                 nullTimeouts(context.TODO()),
 				}
 			},
 			expectedWarning: false,
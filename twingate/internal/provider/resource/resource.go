@@ -18,6 +18,7 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
@@ -41,6 +42,9 @@ import (
 const (
 	DefaultSecurityPolicyName       = "Default Policy"
 	schemaVersion             int64 = 4
+
+	DestroyBehaviorDelete     = "delete"
+	DestroyBehaviorDeactivate = "deactivate"
 )
 
 var (
@@ -53,6 +57,7 @@ var (
 	ErrBypassRoutingWithWildcardAddress   = errors.New("Bypass Resources cannot have a wildcard address")
 	ErrBypassRoutingWithSecurityPolicy    = errors.New("Bypass Resources cannot have a security policy")
 	ErrBypassRoutingWithPortRestriction   = errors.New("Bypass Resources cannot have port restrictions")
+	ErrMultipleDeactivatedResources       = errors.New("found more than one deactivated Resource with the same name in the Remote Network, unable to choose which one to reactivate")
 )
 
 // Ensure the implementation satisfies the desired interfaces.
@@ -63,28 +68,32 @@ func NewResourceResource() resource.Resource {
 }
 
 type twingateResource struct {
-	client      *client.Client
-	defaultTags map[string]string
+	client           *client.Client
+	defaultTags      map[string]string
+	addressConflicts string
 }
 
 type resourceModel struct {
-	ID                       types.String `tfsdk:"id"`
-	Name                     types.String `tfsdk:"name"`
-	Address                  types.String `tfsdk:"address"`
-	RemoteNetworkID          types.String `tfsdk:"remote_network_id"`
-	IsAuthoritative          types.Bool   `tfsdk:"is_authoritative"`
-	Protocols                types.Object `tfsdk:"protocols"`
-	AccessPolicy             types.Set    `tfsdk:"access_policy"`
-	GroupAccess              types.Set    `tfsdk:"access_group"`
-	ServiceAccess            types.Set    `tfsdk:"access_service"`
-	IsActive                 types.Bool   `tfsdk:"is_active"`
-	IsVisible                types.Bool   `tfsdk:"is_visible"`
-	IsBrowserShortcutEnabled types.Bool   `tfsdk:"is_browser_shortcut_enabled"`
-	Alias                    types.String `tfsdk:"alias"`
-	SecurityPolicyID         types.String `tfsdk:"security_policy_id"`
-	RoutingMode              types.String `tfsdk:"routing_mode"`
-	Tags                     types.Map    `tfsdk:"tags"`
-	TagsAll                  types.Map    `tfsdk:"tags_all"`
+	ID                       types.String   `tfsdk:"id"`
+	Name                     types.String   `tfsdk:"name"`
+	Address                  types.String   `tfsdk:"address"`
+	RemoteNetworkID          types.String   `tfsdk:"remote_network_id"`
+	IsAuthoritative          types.Bool     `tfsdk:"is_authoritative"`
+	Protocols                types.Object   `tfsdk:"protocols"`
+	AccessPolicy             types.Set      `tfsdk:"access_policy"`
+	GroupAccess              types.Set      `tfsdk:"access_group"`
+	ServiceAccess            types.Set      `tfsdk:"access_service"`
+	IsActive                 types.Bool     `tfsdk:"is_active"`
+	IsVisible                types.Bool     `tfsdk:"is_visible"`
+	IsBrowserShortcutEnabled types.Bool     `tfsdk:"is_browser_shortcut_enabled"`
+	Alias                    types.String   `tfsdk:"alias"`
+	SecurityPolicyID         types.String   `tfsdk:"security_policy_id"`
+	RoutingMode              types.String   `tfsdk:"routing_mode"`
+	Tags                     types.Map      `tfsdk:"tags"`
+	TagsAll                  types.Map      `tfsdk:"tags_all"`
+	DeletionProtection       types.Bool     `tfsdk:"deletion_protection"`
+	DestroyBehavior          types.String   `tfsdk:"destroy_behavior"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *twingateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -103,6 +112,7 @@ func (r *twingateResource) Configure(_ context.Context, req resource.ConfigureRe
 
 	r.client = providerData.Client
 	r.defaultTags = providerData.DefaultTags
+	r.addressConflicts = providerData.Config.ResourceAddressConflicts
 }
 
 func (r *twingateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -134,6 +144,8 @@ func (r *twingateResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 	// the API default values (mode=MANUAL, approval_mode=MANUAL, no duration).
 	// ModifyPlan runs after all attribute-level plan modifiers, so this override is final.
 	suppressAccessPolicyDefaultDrift(ctx, req, resp)
+
+	r.checkAddressConflicts(ctx, req, resp)
 }
 
 // suppressAccessPolicyDefaultDrift prevents spurious drift after import: Twingate always
@@ -190,19 +202,26 @@ func suppressAccessPolicyDefaultDrift(ctx context.Context, req resource.ModifyPl
 }
 
 func (r *twingateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root(attr.ID), req, resp)
+	id, err := resolveResourceImportID(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to import state", err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.ID), id)...)
 
-	res, err := r.client.ReadResource(ctx, req.ID)
+	res, err := r.client.ReadResource(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to import state", err.Error())
 
 		return
 	}
 
-	resp.State.SetAttribute(ctx, path.Root(attr.SecurityPolicyID), types.StringPointerValue(res.SecurityPolicyID))
-	resp.State.SetAttribute(ctx, path.Root(attr.RoutingMode), types.StringPointerValue(res.RoutingMode))
-	resp.State.SetAttribute(ctx, path.Root(attr.Alias), types.StringPointerValue(res.Alias))
-	resp.State.SetAttribute(ctx, path.Root(attr.IsAuthoritative), types.BoolValue(true))
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.SecurityPolicyID), types.StringPointerValue(res.SecurityPolicyID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.RoutingMode), types.StringPointerValue(res.RoutingMode))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.Alias), types.StringPointerValue(res.Alias))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.IsAuthoritative), types.BoolValue(true))...)
 
 	accessPolicy, diags := convertAccessPolicyToTerraformForImport(ctx, res.AccessPolicy)
 	resp.Diagnostics.Append(diags...)
@@ -211,7 +230,7 @@ func (r *twingateResource) ImportState(ctx context.Context, req resource.ImportS
 		return
 	}
 
-	resp.State.SetAttribute(ctx, path.Root(attr.AccessPolicy), accessPolicy)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.AccessPolicy), accessPolicy)...)
 
 	if res.Protocols != nil {
 		protocols, diags := convertProtocolsToTerraform(res.Protocols, nil)
@@ -221,7 +240,7 @@ func (r *twingateResource) ImportState(ctx context.Context, req resource.ImportS
 			return
 		}
 
-		resp.State.SetAttribute(ctx, path.Root(attr.Protocols), protocols)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.Protocols), protocols)...)
 	}
 
 	if len(res.GroupsAccess) > 0 {
@@ -232,7 +251,7 @@ func (r *twingateResource) ImportState(ctx context.Context, req resource.ImportS
 			return
 		}
 
-		resp.State.SetAttribute(ctx, path.Root(attr.AccessGroup), accessGroup)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.AccessGroup), accessGroup)...)
 	}
 
 	if len(res.ServiceAccounts) > 0 {
@@ -243,16 +262,16 @@ func (r *twingateResource) ImportState(ctx context.Context, req resource.ImportS
 			return
 		}
 
-		resp.State.SetAttribute(ctx, path.Root(attr.AccessService), accessServiceAccount)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.AccessService), accessServiceAccount)...)
 	}
 
-	resp.State.SetAttribute(ctx, path.Root(attr.TagsAll), utils.ConvertMapValue(res.Tags))
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.TagsAll), utils.ConvertMapValue(res.Tags))...)
 	userTags := utils.MapDifference(res.Tags, r.defaultTags)
-	resp.State.SetAttribute(ctx, path.Root(attr.Tags), utils.ConvertMapValue(userTags))
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.Tags), utils.ConvertMapValue(userTags))...)
 }
 
 //nolint:funlen
-func (r *twingateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *twingateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Version:     schemaVersion,
 		Description: "Resources in Twingate represent servers on the private network that clients can connect to. Resources can be defined by IP, CIDR range, FQDN, or DNS zone. For more information, see the Twingate [documentation](https://docs.twingate.com/docs/resources-and-access-nodes).",
@@ -263,7 +282,7 @@ func (r *twingateResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			},
 			attr.Address: schema.StringAttribute{
 				Required:    true,
-				Description: "The Resource's IP/CIDR or FQDN/DNS zone",
+				Description: "The Resource's IP/CIDR or FQDN/DNS zone. Duplicates, overlaps and wildcard shadowing with other Resources in the same Remote Network are reported at plan time, see the provider's `resource_address_conflicts` setting.",
 			},
 			attr.RemoteNetworkID: schema.StringAttribute{
 				Required:    true,
@@ -301,6 +320,16 @@ func (r *twingateResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:    true,
 				Description: "A map of key-value pairs that represents all tags on this resource, including default tags from provider configuration.",
 			},
+			attr.DeletionProtection: deletionProtectionAttribute(),
+			attr.DestroyBehavior: schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: fmt.Sprintf("Controls what happens to the Resource on destroy. Valid values are `%[1]s` (default) and `%[2]s`. With `%[2]s` the Resource is only set inactive, and a later create with the same name and Remote Network reactivates and adopts it.", DestroyBehaviorDelete, DestroyBehaviorDeactivate),
+				Default:     stringdefault.StaticString(DestroyBehaviorDelete),
+				Validators: []validator.String{
+					stringvalidator.OneOf(DestroyBehaviorDelete, DestroyBehaviorDeactivate),
+				},
+			},
 			// computed
 			attr.SecurityPolicyID: schema.StringAttribute{
 				Optional:    true,
@@ -343,6 +372,7 @@ func (r *twingateResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 		},
 
 		Blocks: map[string]schema.Block{
+			attr.Timeouts:      timeoutsBlock(ctx),
 			attr.AccessGroup:   groupAccessBlock(),
 			attr.AccessService: serviceAccessBlock(),
 			attr.AccessPolicy:  accessPolicyBlock(),
@@ -605,6 +635,9 @@ func (r *twingateResource) Create(ctx context.Context, req resource.CreateReques
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -616,6 +649,21 @@ func (r *twingateResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	if plan.DestroyBehavior.ValueString() == DestroyBehaviorDeactivate {
+		resource, err := r.adoptDeactivatedResource(ctx, input)
+		if err != nil {
+			addErr(&resp.Diagnostics, err, operationCreate, TwingateResource)
+
+			return
+		}
+
+		if resource != nil {
+			r.helper(ctx, resource, &plan, &plan, &resp.State, &resp.Diagnostics, nil, operationCreate)
+
+			return
+		}
+	}
+
 	resource, err := r.client.CreateResource(ctx, input)
 	if err != nil {
 		addErr(&resp.Diagnostics, err, operationCreate, TwingateResource)
@@ -645,6 +693,57 @@ func (r *twingateResource) Create(ctx context.Context, req resource.CreateReques
 	r.helper(ctx, resource, &plan, &plan, &resp.State, &resp.Diagnostics, err, operationCreate)
 }
 
+// adoptDeactivatedResource looks for a single inactive Resource with the planned name in the planned
+// Remote Network (left behind by destroy_behavior = "deactivate"), then updates and reactivates it.
+// It returns nil without error when there is nothing to adopt.
+func (r *twingateResource) adoptDeactivatedResource(ctx context.Context, input *model.Resource) (*model.Resource, error) {
+	resources, err := r.client.ReadResourcesByName(ctx, &model.ResourcesFilter{
+		Name:            &input.Name,
+		RemoteNetworkID: &input.RemoteNetworkID,
+	})
+	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		return nil, fmt.Errorf("failed to look up deactivated resources: %w", err)
+	}
+
+	var deactivated []*model.Resource
+
+	for _, res := range resources {
+		if !res.IsActive && res.Name == input.Name && res.RemoteNetworkID == input.RemoteNetworkID {
+			deactivated = append(deactivated, res)
+		}
+	}
+
+	switch len(deactivated) {
+	case 0:
+		return nil, nil //nolint:nilnil
+	case 1:
+	default:
+		return nil, ErrMultipleDeactivatedResources
+	}
+
+	input.ID = deactivated[0].ID
+
+	remote, err := r.client.ReadResource(ctx, input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read deactivated resource: %w", err)
+	}
+
+	// the adopted object is fully managed from now on, so any access it kept is replaced by the planned one
+	idsToDelete := append(setDifferenceGroups(remote.GroupsAccess, input.GroupsAccess), setDifference(remote.ServiceAccounts, input.ServiceAccounts)...)
+	if err := r.client.RemoveResourceAccess(ctx, input.ID, idsToDelete); err != nil {
+		return nil, fmt.Errorf("failed to update resource access: %w", err)
+	}
+
+	groupsToAdd := setDifferenceGroupAccess(input.GroupsAccess, remote.GroupsAccess)
+	serviceAccountsToAdd := setDifference(input.ServiceAccounts, remote.ServiceAccounts)
+
+	if err := r.client.AddResourceAccess(ctx, input.ID, convertResourceAccess(serviceAccountsToAdd, groupsToAdd)); err != nil {
+		return nil, fmt.Errorf("failed to update resource access: %w", err)
+	}
+
+	return r.client.UpdateResource(ctx, input) //nolint:wrapcheck
+}
+
 func convertResourceAccess(serviceAccounts []string, groupsAccess []model.AccessGroup) []client.AccessInput {
 	access := make([]client.AccessInput, 0, len(serviceAccounts)+len(groupsAccess))
 	for _, account := range serviceAccounts {
@@ -1011,6 +1110,9 @@ func (r *twingateResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1034,6 +1136,9 @@ func (r *twingateResource) Update(ctx context.Context, req resource.UpdateReques
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1161,10 +1266,27 @@ func (r *twingateResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if isDeletionProtected(state.DeletionProtection, &resp.Diagnostics, TwingateResource) {
+		return
+	}
+
+	if state.DestroyBehavior.ValueString() == DestroyBehaviorDeactivate {
+		err := r.client.UpdateResourceActiveState(ctx, &model.Resource{
+			ID:       state.ID.ValueString(),
+			IsActive: false,
+		})
+		addErr(&resp.Diagnostics, err, operationDelete, TwingateResource)
+
+		return
+	}
+
 	err := r.client.DeleteResource(ctx, state.ID.ValueString())
 	addErr(&resp.Diagnostics, err, operationDelete, TwingateResource)
 }
@@ -1282,6 +1404,12 @@ func setState(ctx context.Context, state, reference *resourceModel, resource *mo
 	state.ServiceAccess = serviceAccess
 	state.TagsAll = utils.ConvertMapValue(resource.Tags)
 	state.Tags = reference.Tags
+	state.DeletionProtection = deletionProtectionValue(reference.DeletionProtection)
+	state.DestroyBehavior = reference.DestroyBehavior
+
+	if state.DestroyBehavior.IsNull() || state.DestroyBehavior.IsUnknown() {
+		state.DestroyBehavior = types.StringValue(DestroyBehaviorDelete)
+	}
 }
 
 func convertProtocolsToTerraform(protocols *model.Protocols, reference *types.Object) (types.Object, diag.Diagnostics) {
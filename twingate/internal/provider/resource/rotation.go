@@ -0,0 +1,151 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// privateStateKeyCreatedAt stores when a rotated credential was issued.
+	privateStateKeyCreatedAt = "created_at"
+	// privateStateKeyRetiredIDs stores the replaced credentials that are still to be removed.
+	privateStateKeyRetiredIDs = "retired_ids"
+)
+
+var ErrRotateBeforeExpiryTooLong = errors.New("rotate_before_expiry must be shorter than the credential's lifetime")
+
+// privateStateGetter and privateStateSetter match the private state of the framework's
+// requests and responses, which is an internal type.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+type createdAtPrivateState struct {
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func setCreatedAt(ctx context.Context, private privateStateSetter, createdAt time.Time) diag.Diagnostics {
+	value, err := json.Marshal(createdAtPrivateState{CreatedAt: createdAt.UTC()})
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("failed to store creation time", err.Error())
+
+		return diags
+	}
+
+	return private.SetKey(ctx, privateStateKeyCreatedAt, value)
+}
+
+// getCreatedAt returns the zero time when no creation time is stored yet.
+func getCreatedAt(ctx context.Context, private privateStateGetter) (time.Time, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, privateStateKeyCreatedAt)
+	if diags.HasError() || len(value) == 0 {
+		return time.Time{}, diags
+	}
+
+	var state createdAtPrivateState
+	if err := json.Unmarshal(value, &state); err != nil {
+		diags.AddError("failed to read creation time", err.Error())
+
+		return time.Time{}, diags
+	}
+
+	return state.CreatedAt, diags
+}
+
+// ensureCreatedAt starts the rotation clock for credentials created before rotation was supported.
+func ensureCreatedAt(ctx context.Context, getter privateStateGetter, setter privateStateSetter) diag.Diagnostics {
+	createdAt, diags := getCreatedAt(ctx, getter)
+	if diags.HasError() || !createdAt.IsZero() {
+		return diags
+	}
+
+	return setCreatedAt(ctx, setter, time.Now())
+}
+
+type retiredIDsPrivateState struct {
+	IDs []string `json:"ids"`
+}
+
+// setRetiredIDs stores the IDs of replaced credentials that couldn't be removed yet, and clears the
+// key when there are none left.
+func setRetiredIDs(ctx context.Context, private privateStateSetter, ids []string) diag.Diagnostics {
+	if len(ids) == 0 {
+		return private.SetKey(ctx, privateStateKeyRetiredIDs, nil)
+	}
+
+	value, err := json.Marshal(retiredIDsPrivateState{IDs: ids})
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("failed to store replaced credentials", err.Error())
+
+		return diags
+	}
+
+	return private.SetKey(ctx, privateStateKeyRetiredIDs, value)
+}
+
+func getRetiredIDs(ctx context.Context, private privateStateGetter) ([]string, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, privateStateKeyRetiredIDs)
+	if diags.HasError() || len(value) == 0 {
+		return nil, diags
+	}
+
+	var state retiredIDsPrivateState
+	if err := json.Unmarshal(value, &state); err != nil {
+		diags.AddError("failed to read replaced credentials", err.Error())
+
+		return nil, diags
+	}
+
+	return state.IDs, diags
+}
+
+// optionalDuration parses a validated duration attribute, treating null and unknown as zero.
+func optionalDuration(value types.String) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return 0
+	}
+
+	duration, err := utils.ParseDurationWithDays(value.ValueString())
+	if err != nil {
+		return 0
+	}
+
+	return duration
+}
+
+// isRotationDue reports whether a credential issued at createdAt must be replaced at now: either
+// rotationPeriod has elapsed, or it expires after lifetime and is within rotateBeforeExpiry of it.
+func isRotationDue(now, createdAt time.Time, rotationPeriod, rotateBeforeExpiry, lifetime time.Duration) bool {
+	if createdAt.IsZero() {
+		return false
+	}
+
+	if rotationPeriod > 0 && !now.Before(createdAt.Add(rotationPeriod)) {
+		return true
+	}
+
+	return lifetime > 0 && rotateBeforeExpiry > 0 && !now.Before(createdAt.Add(lifetime-rotateBeforeExpiry))
+}
+
+// validateRotateBeforeExpiry rejects a rotateBeforeExpiry that isn't shorter than the lifetime, which would
+// make the rotation due as soon as the credential is issued and rotate it on every apply.
+func validateRotateBeforeExpiry(rotateBeforeExpiry, lifetime time.Duration) error {
+	if lifetime > 0 && rotateBeforeExpiry >= lifetime {
+		return fmt.Errorf("%w: %s is not shorter than %s", ErrRotateBeforeExpiryTooLong, rotateBeforeExpiry, lifetime)
+	}
+
+	return nil
+}
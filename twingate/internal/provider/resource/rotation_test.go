@@ -0,0 +1,98 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type testPrivateState map[string][]byte
+
+func (s testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return s[key], nil
+}
+
+func (s testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	s[key] = value
+
+	return nil
+}
+
+func TestIsRotationDue(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	day := hoursInDay * time.Hour
+
+	cases := []struct {
+		createdAt          time.Time
+		rotationPeriod     time.Duration
+		rotateBeforeExpiry time.Duration
+		lifetime           time.Duration
+		expected           bool
+	}{
+		// no creation time recorded yet
+		{createdAt: time.Time{}, rotationPeriod: day, expected: false},
+		// rotation disabled
+		{createdAt: now.Add(-100 * day), expected: false},
+		{createdAt: now.Add(-29 * day), rotationPeriod: 30 * day, expected: false},
+		{createdAt: now.Add(-30 * day), rotationPeriod: 30 * day, expected: true},
+		// expires in 8 days
+		{createdAt: now.Add(-82 * day), rotateBeforeExpiry: 7 * day, lifetime: 90 * day, expected: false},
+		// expires in 7 days
+		{createdAt: now.Add(-83 * day), rotateBeforeExpiry: 7 * day, lifetime: 90 * day, expected: true},
+		// never expires
+		{createdAt: now.Add(-83 * day), rotateBeforeExpiry: 7 * day, expected: false},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, isRotationDue(now, c.createdAt, c.rotationPeriod, c.rotateBeforeExpiry, c.lifetime))
+		})
+	}
+}
+
+func TestValidateRotateBeforeExpiry(t *testing.T) {
+	day := hoursInDay * time.Hour
+
+	assert.NoError(t, validateRotateBeforeExpiry(7*day, 30*day))
+	assert.NoError(t, validateRotateBeforeExpiry(0, 30*day))
+	// never expires
+	assert.NoError(t, validateRotateBeforeExpiry(400*day, 0))
+	assert.ErrorIs(t, validateRotateBeforeExpiry(30*day, 30*day), ErrRotateBeforeExpiryTooLong)
+	assert.ErrorIs(t, validateRotateBeforeExpiry(31*day, 30*day), ErrRotateBeforeExpiryTooLong)
+}
+
+func TestOptionalDuration(t *testing.T) {
+	assert.Equal(t, time.Duration(0), optionalDuration(types.StringNull()))
+	assert.Equal(t, time.Duration(0), optionalDuration(types.StringUnknown()))
+	assert.Equal(t, 30*hoursInDay*time.Hour, optionalDuration(types.StringValue("30d")))
+	assert.Equal(t, 12*time.Hour, optionalDuration(types.StringValue("12h")))
+}
+
+func TestCreatedAtPrivateState(t *testing.T) {
+	ctx := context.Background()
+	private := testPrivateState{}
+
+	createdAt, diags := getCreatedAt(ctx, private)
+	assert.False(t, diags.HasError())
+	assert.True(t, createdAt.IsZero())
+
+	expected := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	diags = setCreatedAt(ctx, private, expected)
+	assert.False(t, diags.HasError())
+
+	createdAt, diags = getCreatedAt(ctx, private)
+	assert.False(t, diags.HasError())
+	assert.True(t, expected.Equal(createdAt))
+
+	// an existing creation time is kept
+	diags = ensureCreatedAt(ctx, private, private)
+	assert.False(t, diags.HasError())
+
+	createdAt, _ = getCreatedAt(ctx, private)
+	assert.True(t, expected.Equal(createdAt))
+}
@@ -0,0 +1,270 @@
+package resource
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	secretFileMode   = 0o600
+	vaultTokenHeader = "X-Vault-Token"
+)
+
+var ErrSecretSinkHTTPStatus = errors.New("unexpected response status")
+
+type secretSinkModel struct {
+	File    types.String `tfsdk:"file"`
+	Command types.List   `tfsdk:"command"`
+	HTTP    types.Object `tfsdk:"http"`
+}
+
+type secretSinkHTTPModel struct {
+	URL   types.String `tfsdk:"url"`
+	Token types.String `tfsdk:"token"`
+}
+
+// secretSinkAttribute lets freshly minted secrets be handed to an external store instead of
+// being kept in state. Changing the destination re-creates the resource, so the new sink receives
+// a new secret, while the write-only HTTP token can be rotated in place.
+func secretSinkAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Delivers the generated secrets to the configured sink when they are created or rotated, and keeps only their SHA-256 hashes in state. The secrets are passed as a JSON object. Exactly one of `file`, `command` or `http` must be set. Changing the destination re-creates the resource.",
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplaceIf(secretSinkDestinationChanged,
+				"Changing the secret sink destination re-creates the resource, so the new sink receives a new secret.",
+				"Changing the secret sink destination re-creates the resource, so the new sink receives a new secret."),
+		},
+		Validators: []validator.Object{
+			objectvalidator.ExactlyOneOf(
+				path.MatchRelative().AtName(attr.File),
+				path.MatchRelative().AtName(attr.Command),
+				path.MatchRelative().AtName(attr.HTTP),
+			),
+		},
+		Attributes: map[string]schema.Attribute{
+			attr.File: schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file the secrets are written to with `0600` permissions.",
+			},
+			attr.Command: schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Command and arguments of a program that receives the secrets on stdin.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			attr.HTTP: schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Vault-compatible KV v2 endpoint the secrets are posted to as `{\"data\": {...}}`.",
+				Attributes: map[string]schema.Attribute{
+					attr.URL: schema.StringAttribute{
+						Required:    true,
+						Description: "URL of the secret, e.g. `https://vault.example.com/v1/secret/data/twingate/connector`.",
+					},
+					attr.Token: schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						WriteOnly:   true,
+						Description: "Token sent in the `X-Vault-Token` header. This field is write-only and is never kept in state, so it can be changed without re-creating the resource.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// secretHashAttribute is the SHA-256 hash of a secret that was delivered to the secret sink.
+func secretHashAttribute(secret string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed:      true,
+		Description:   fmt.Sprintf("Hex-encoded SHA-256 hash of the %s. Only set when `secret_sink` is configured.", secret),
+		PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+	}
+}
+
+func secretHash(secret string) types.String {
+	sum := sha256.Sum256([]byte(secret))
+
+	return types.StringValue(hex.EncodeToString(sum[:]))
+}
+
+func isSecretSinkSet(sink types.Object) bool {
+	return !sink.IsNull() && !sink.IsUnknown()
+}
+
+// secretSinkDestinationChanged ignores the write-only token, which is null in both plan and state.
+func secretSinkDestinationChanged(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.PlanValue.IsNull() != req.StateValue.IsNull() || req.PlanValue.IsUnknown() {
+		resp.RequiresReplace = true
+
+		return
+	}
+
+	var planned, prior secretSinkModel
+
+	resp.Diagnostics.Append(req.PlanValue.As(ctx, &planned, basetypes.ObjectAsOptions{})...)
+	resp.Diagnostics.Append(req.StateValue.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.RequiresReplace = !planned.File.Equal(prior.File) ||
+		!planned.Command.Equal(prior.Command) ||
+		secretSinkURL(ctx, planned.HTTP, &resp.Diagnostics) != secretSinkURL(ctx, prior.HTTP, &resp.Diagnostics) ||
+		planned.HTTP.IsNull() != prior.HTTP.IsNull()
+}
+
+func secretSinkURL(ctx context.Context, sink types.Object, diagnostics *diag.Diagnostics) string {
+	if sink.IsNull() || sink.IsUnknown() {
+		return ""
+	}
+
+	var httpModel secretSinkHTTPModel
+
+	diagnostics.Append(sink.As(ctx, &httpModel, basetypes.ObjectAsOptions{})...)
+
+	return httpModel.URL.ValueString()
+}
+
+// deliverSecrets hands the secrets to the sink in the resource configuration, the only place
+// where the write-only HTTP token is available.
+func deliverSecrets(ctx context.Context, config tfsdk.Config, secrets map[string]string) diag.Diagnostics {
+	var (
+		diags     diag.Diagnostics
+		sink      types.Object
+		sinkModel secretSinkModel
+	)
+
+	diags.Append(config.GetAttribute(ctx, path.Root(attr.SecretSink), &sink)...)
+
+	if diags.HasError() || !isSecretSinkSet(sink) {
+		return diags
+	}
+
+	diags.Append(sink.As(ctx, &sinkModel, basetypes.ObjectAsOptions{})...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	payload, err := json.Marshal(secrets)
+	if err != nil {
+		diags.AddError("failed to deliver secrets", err.Error())
+
+		return diags
+	}
+
+	switch {
+	case !sinkModel.File.IsNull():
+		err = writeSecretFile(sinkModel.File.ValueString(), payload)
+	case !sinkModel.Command.IsNull():
+		var command []string
+
+		diags.Append(sinkModel.Command.ElementsAs(ctx, &command, false)...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		err = runSecretCommand(ctx, command, payload)
+	case !sinkModel.HTTP.IsNull():
+		var httpModel secretSinkHTTPModel
+
+		diags.Append(sinkModel.HTTP.As(ctx, &httpModel, basetypes.ObjectAsOptions{})...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		err = postSecret(ctx, httpModel.URL.ValueString(), httpModel.Token.ValueString(), secrets)
+	}
+
+	if err != nil {
+		diags.AddError("failed to deliver secrets", err.Error())
+	}
+
+	return diags
+}
+
+func writeSecretFile(filePath string, payload []byte) error {
+	if err := os.WriteFile(filePath, payload, secretFileMode); err != nil {
+		return fmt.Errorf("failed to write %s: %w", filePath, err)
+	}
+
+	// WriteFile keeps the mode of an existing file
+	if err := os.Chmod(filePath, secretFileMode); err != nil {
+		return fmt.Errorf("failed to restrict permissions of %s: %w", filePath, err)
+	}
+
+	return nil
+}
+
+func runSecretCommand(ctx context.Context, command []string, payload []byte) error {
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...) //nolint:gosec
+	cmd.Stdin = bytes.NewReader(payload)
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("command %q failed: %w: %s", command[0], err, bytes.TrimSpace(output))
+	}
+
+	return nil
+}
+
+func postSecret(ctx context.Context, url, token string, secrets map[string]string) error {
+	body, err := json.Marshal(map[string]any{"data": secrets})
+	if err != nil {
+		return fmt.Errorf("failed to encode secrets: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if token != "" {
+		req.Header.Set(vaultTokenHeader, token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post secrets to %s: %w", url, err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024)) //nolint:mnd
+
+		return fmt.Errorf("%w %d from %s: %s", ErrSecretSinkHTTPStatus, resp.StatusCode, url, bytes.TrimSpace(message))
+	}
+
+	return nil
+}
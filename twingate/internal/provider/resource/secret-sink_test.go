@@ -0,0 +1,124 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretHash(t *testing.T) {
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", secretHash("hello").ValueString())
+}
+
+func TestWriteSecretFile(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "secret.json")
+
+	assert.NoError(t, os.WriteFile(filePath, []byte("old"), 0o644))
+	assert.NoError(t, writeSecretFile(filePath, []byte(`{"token":"secret"}`)))
+
+	content, err := os.ReadFile(filePath)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"token":"secret"}`, string(content))
+
+	info, err := os.Stat(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(secretFileMode), info.Mode().Perm())
+}
+
+func TestRunSecretCommand(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "secret.json")
+
+	assert.NoError(t, runSecretCommand(context.Background(), []string{"sh", "-c", "cat > " + filePath}, []byte(`{"token":"secret"}`)))
+
+	content, err := os.ReadFile(filePath)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"token":"secret"}`, string(content))
+
+	assert.ErrorContains(t, runSecretCommand(context.Background(), []string{"sh", "-c", "echo denied; exit 1"}, nil), "denied")
+}
+
+func TestPostSecret(t *testing.T) {
+	var (
+		body  map[string]map[string]string
+		token string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token = r.Header.Get(vaultTokenHeader)
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		if r.URL.Path == "/forbidden" {
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+
+	err := postSecret(context.Background(), server.URL+"/v1/secret/data/key", "vault-token", map[string]string{"token": "secret"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "vault-token", token)
+	assert.Equal(t, map[string]map[string]string{"data": {"token": "secret"}}, body)
+
+	err = postSecret(context.Background(), server.URL+"/forbidden", "", map[string]string{"token": "secret"})
+
+	assert.ErrorIs(t, err, ErrSecretSinkHTTPStatus)
+}
+
+func TestSecretSinkDestinationChanged(t *testing.T) {
+	ctx := context.Background()
+
+	sinkType, _ := secretSinkAttribute().GetType().(types.ObjectType)
+	httpType, _ := sinkType.AttrTypes[attr.HTTP].(types.ObjectType)
+
+	httpSink := func(url string) types.Object {
+		return types.ObjectValueMust(sinkType.AttrTypes, map[string]tfattr.Value{
+			attr.File:    types.StringNull(),
+			attr.Command: types.ListNull(types.StringType),
+			attr.HTTP: types.ObjectValueMust(httpType.AttrTypes, map[string]tfattr.Value{
+				attr.URL: types.StringValue(url),
+				// write-only, always null outside the configuration
+				attr.Token: types.StringNull(),
+			}),
+		})
+	}
+
+	fileSink := types.ObjectValueMust(sinkType.AttrTypes, map[string]tfattr.Value{
+		attr.File:    types.StringValue("/tmp/secret.json"),
+		attr.Command: types.ListNull(types.StringType),
+		attr.HTTP:    types.ObjectNull(httpType.AttrTypes),
+	})
+
+	cases := []struct {
+		name     string
+		state    types.Object
+		plan     types.Object
+		expected bool
+	}{
+		{name: "same destination", state: httpSink("https://vault/a"), plan: httpSink("https://vault/a"), expected: false},
+		{name: "url changed", state: httpSink("https://vault/a"), plan: httpSink("https://vault/b"), expected: true},
+		{name: "sink type changed", state: httpSink("https://vault/a"), plan: fileSink, expected: true},
+		{name: "sink removed", state: fileSink, plan: types.ObjectNull(sinkType.AttrTypes), expected: true},
+		{name: "sink added", state: types.ObjectNull(sinkType.AttrTypes), plan: fileSink, expected: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resp := &objectplanmodifier.RequiresReplaceIfFuncResponse{}
+			secretSinkDestinationChanged(ctx, planmodifier.ObjectRequest{PlanValue: c.plan, StateValue: c.state}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, c.expected, resp.RequiresReplace)
+		})
+	}
+}
@@ -3,18 +3,24 @@ package resource
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/customvalidator"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -22,7 +28,11 @@ import (
 var ErrInvalidExpirationTime = errors.New("Invalid key expiration time. A value from 0-365 is required.")
 
 // Ensure the implementation satisfies the desired interfaces.
-var _ resource.Resource = &serviceKey{}
+var (
+	_ resource.Resource                   = &serviceKey{}
+	_ resource.ResourceWithModifyPlan     = &serviceKey{}
+	_ resource.ResourceWithValidateConfig = &serviceKey{}
+)
 
 func NewServiceKeyResource() resource.Resource {
 	return &serviceKey{}
@@ -33,12 +43,17 @@ type serviceKey struct {
 }
 
 type serviceKeyModel struct {
-	ID               types.String `tfsdk:"id"`
-	ServiceAccountID types.String `tfsdk:"service_account_id"`
-	Name             types.String `tfsdk:"name"`
-	Token            types.String `tfsdk:"token"`
-	IsActive         types.Bool   `tfsdk:"is_active"`
-	ExpirationTime   types.Int64  `tfsdk:"expiration_time"`
+	ID                 types.String   `tfsdk:"id"`
+	ServiceAccountID   types.String   `tfsdk:"service_account_id"`
+	Name               types.String   `tfsdk:"name"`
+	Token              types.String   `tfsdk:"token"`
+	IsActive           types.Bool     `tfsdk:"is_active"`
+	ExpirationTime     types.Int64    `tfsdk:"expiration_time"`
+	RotationPeriod     types.String   `tfsdk:"rotation_period"`
+	RotateBeforeExpiry types.String   `tfsdk:"rotate_before_expiry"`
+	SecretSink         types.Object   `tfsdk:"secret_sink"`
+	TokenSHA256        types.String   `tfsdk:"token_sha256"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *serviceKey) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,7 +73,7 @@ func (r *serviceKey) Configure(_ context.Context, req resource.ConfigureRequest,
 	r.client = providerData.Client
 }
 
-func (r *serviceKey) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *serviceKey) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "A Service Key authorizes access to all Resources assigned to a Service Account.",
 		Attributes: map[string]schema.Attribute{
@@ -84,6 +99,17 @@ func (r *serviceKey) Schema(_ context.Context, _ resource.SchemaRequest, resp *r
 					int64planmodifier.RequiresReplace(),
 				},
 			},
+			attr.RotationPeriod: schema.StringAttribute{
+				Optional:    true,
+				Description: "How long a key is used before Terraform rotates it, e.g. `30d`. The new key is created, and its token delivered to `secret_sink`, before the old one is revoked. An old key that fails to be removed is retried on the next apply. Rotation is planned on the first plan after the period has elapsed.",
+				Validators:  []validator.String{customvalidator.Duration()},
+			},
+			attr.RotateBeforeExpiry: schema.StringAttribute{
+				Optional:    true,
+				Description: "How long before `expiration_time` is reached Terraform rotates the key, e.g. `7d`. Only applies when `expiration_time` is set, and must be shorter than it.",
+				Validators:  []validator.String{customvalidator.Duration()},
+			},
+			attr.SecretSink: secretSinkAttribute(),
 			// computed
 			attr.ID: schema.StringAttribute{
 				Computed:      true,
@@ -93,13 +119,17 @@ func (r *serviceKey) Schema(_ context.Context, _ resource.SchemaRequest, resp *r
 			attr.Token: schema.StringAttribute{
 				Computed:    true,
 				Sensitive:   true,
-				Description: "Autogenerated Service Key token. Used to configure a Twingate Client running in headless mode.",
+				Description: "Autogenerated Service Key token. Used to configure a Twingate Client running in headless mode. Not kept in state when `secret_sink` is configured.",
 			},
+			attr.TokenSHA256: secretHashAttribute("token"),
 			attr.IsActive: schema.BoolAttribute{
 				Computed:    true,
 				Description: "If the value of this attribute changes to false, Terraform will destroy and recreate the resource.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			attr.Timeouts: timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -108,6 +138,9 @@ func (r *serviceKey) Create(ctx context.Context, req resource.CreateRequest, res
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -126,10 +159,15 @@ func (r *serviceKey) Create(ctx context.Context, req resource.CreateRequest, res
 	})
 
 	if err == nil && serviceKey != nil {
-		plan.Token = types.StringValue(serviceKey.Token)
+		setServiceKeyToken(&plan, serviceKey.Token)
 	}
 
 	r.helper(ctx, serviceKey, &plan, &resp.State, &resp.Diagnostics, err, operationCreate)
+
+	if err == nil {
+		resp.Diagnostics.Append(setCreatedAt(ctx, resp.Private, time.Now())...)
+		resp.Diagnostics.Append(deliverServiceKeyToken(ctx, req.Config, &plan, serviceKey.Token)...)
+	}
 }
 
 func (r *serviceKey) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -137,6 +175,9 @@ func (r *serviceKey) Read(ctx context.Context, req resource.ReadRequest, resp *r
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -144,6 +185,10 @@ func (r *serviceKey) Read(ctx context.Context, req resource.ReadRequest, resp *r
 	serviceKey, err := r.client.ReadServiceKey(ctx, state.ID.ValueString())
 
 	r.helper(ctx, serviceKey, &state, &resp.State, &resp.Diagnostics, err, operationRead)
+
+	if err == nil {
+		resp.Diagnostics.Append(ensureCreatedAt(ctx, req.Private, resp.Private)...)
+	}
 }
 
 func (r *serviceKey) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -152,10 +197,29 @@ func (r *serviceKey) Update(ctx context.Context, req resource.UpdateRequest, res
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	retiredIDs, diags := getRetiredIDs(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the ID is only unknown when ModifyPlan scheduled a rotation
+	if plan.ID.IsUnknown() {
+		r.rotate(ctx, req.Config, &plan, &state, retiredIDs, resp)
+
+		return
+	}
+
+	r.retireServiceKeys(ctx, retiredIDs, resp.Private, &resp.Diagnostics)
+
 	serviceKey, err := r.client.UpdateServiceKey(ctx,
 		&model.ServiceKey{
 			ID:   state.ID.ValueString(),
@@ -163,6 +227,10 @@ func (r *serviceKey) Update(ctx context.Context, req resource.UpdateRequest, res
 		},
 	)
 
+	state.RotationPeriod = plan.RotationPeriod
+	state.RotateBeforeExpiry = plan.RotateBeforeExpiry
+	state.Timeouts = plan.Timeouts
+
 	r.helper(ctx, serviceKey, &state, &resp.State, &resp.Diagnostics, err, operationUpdate)
 }
 
@@ -171,6 +239,9 @@ func (r *serviceKey) Delete(ctx context.Context, req resource.DeleteRequest, res
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -194,6 +265,157 @@ func (r *serviceKey) Delete(ctx context.Context, req resource.DeleteRequest, res
 	addErr(&resp.Diagnostics, err, operationDelete, TwingateServiceAccountKey)
 }
 
+// rotate replaces the key without a gap: the new key is created and its token delivered before it
+// is stored and the old key is retired. When the delivery fails, the new key is removed again and
+// the old one is kept.
+func (r *serviceKey) rotate(ctx context.Context, config tfsdk.Config, plan, state *serviceKeyModel, retiredIDs []string, resp *resource.UpdateResponse) {
+	serviceKey, err := r.client.CreateServiceKey(ctx, &model.ServiceKey{
+		Service:        plan.ServiceAccountID.ValueString(),
+		Name:           plan.Name.ValueString(),
+		ExpirationTime: int(plan.ExpirationTime.ValueInt64()),
+	})
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationUpdate, TwingateServiceAccountKey)
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+
+		return
+	}
+
+	if diags := deliverServiceKeyToken(ctx, config, plan, serviceKey.Token); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+
+		if err := r.retireServiceKey(ctx, serviceKey.ID); err != nil {
+			addErr(&resp.Diagnostics, fmt.Errorf("failed to remove undelivered key %s: %w", serviceKey.ID, err), operationUpdate, TwingateServiceAccountKey)
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+
+		return
+	}
+
+	setServiceKeyToken(plan, serviceKey.Token)
+	r.helper(ctx, serviceKey, plan, &resp.State, &resp.Diagnostics, nil, operationUpdate)
+	resp.Diagnostics.Append(setCreatedAt(ctx, resp.Private, time.Now())...)
+
+	r.retireServiceKeys(ctx, append(retiredIDs, state.ID.ValueString()), resp.Private, &resp.Diagnostics)
+}
+
+// retireServiceKeys removes the keys replaced by a rotation. The keys that couldn't be removed are
+// kept in private state, and ModifyPlan schedules an update to retry them on the next apply.
+func (r *serviceKey) retireServiceKeys(ctx context.Context, keyIDs []string, private privateStateSetter, diagnostics *diag.Diagnostics) {
+	remaining := make([]string, 0, len(keyIDs))
+
+	for _, keyID := range keyIDs {
+		if err := r.retireServiceKey(ctx, keyID); err != nil {
+			addErr(diagnostics, fmt.Errorf("failed to remove rotated key %s, it will be retried on the next apply: %w", keyID, err), operationUpdate, TwingateServiceAccountKey)
+
+			remaining = append(remaining, keyID)
+		}
+	}
+
+	diagnostics.Append(setRetiredIDs(ctx, private, remaining)...)
+}
+
+// retireServiceKey revokes the key when it's still active and deletes it.
+func (r *serviceKey) retireServiceKey(ctx context.Context, keyID string) error {
+	serviceKey, err := r.client.ReadServiceKey(ctx, keyID)
+	if err != nil {
+		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+			return nil
+		}
+
+		return err //nolint:wrapcheck
+	}
+
+	if serviceKey.IsActive() {
+		if err := r.client.RevokeServiceKey(ctx, keyID); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+
+	return r.client.DeleteServiceKey(ctx, keyID) //nolint:wrapcheck
+}
+
+// ModifyPlan schedules a rotation once the key is older than rotation_period, or when it is
+// within rotate_before_expiry of its expiration, and an update while rotated keys remain to be removed.
+func (r *serviceKey) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to rotate on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan serviceKeyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	createdAt, diags := getCreatedAt(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	retiredIDs, diags := getRetiredIDs(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lifetime := serviceKeyLifetime(plan.ExpirationTime)
+
+	switch {
+	case isRotationDue(time.Now(), createdAt, optionalDuration(plan.RotationPeriod), optionalDuration(plan.RotateBeforeExpiry), lifetime):
+		plan.ID = types.StringUnknown()
+		plan.Token = types.StringUnknown()
+		plan.TokenSHA256 = types.StringUnknown()
+		plan.IsActive = types.BoolUnknown()
+	case len(retiredIDs) > 0:
+		// update the key to retry removing the keys a previous rotation left behind
+		plan.IsActive = types.BoolUnknown()
+	default:
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *serviceKey) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config serviceKeyModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() || config.ExpirationTime.IsUnknown() {
+		return
+	}
+
+	if err := validateRotateBeforeExpiry(optionalDuration(config.RotateBeforeExpiry), serviceKeyLifetime(config.ExpirationTime)); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(attr.RotateBeforeExpiry), "Invalid rotate_before_expiry", err.Error())
+	}
+}
+
+// serviceKeyLifetime converts expiration_time in days, zero when the key never expires.
+func serviceKeyLifetime(expirationTime types.Int64) time.Duration {
+	return time.Duration(expirationTime.ValueInt64()) * hoursInDay * time.Hour
+}
+
+// setServiceKeyToken keeps the token in state, or only its hash when a secret sink is configured.
+func setServiceKeyToken(state *serviceKeyModel, token string) {
+	if isSecretSinkSet(state.SecretSink) {
+		state.Token = types.StringNull()
+		state.TokenSHA256 = secretHash(token)
+
+		return
+	}
+
+	state.Token = types.StringValue(token)
+	state.TokenSHA256 = types.StringNull()
+}
+
+func deliverServiceKeyToken(ctx context.Context, config tfsdk.Config, state *serviceKeyModel, token string) diag.Diagnostics {
+	if !isSecretSinkSet(state.SecretSink) {
+		return nil
+	}
+
+	return deliverSecrets(ctx, config, map[string]string{attr.Token: token})
+}
+
 func (r *serviceKey) helper(ctx context.Context, serviceKey *model.ServiceKey, state *serviceKeyModel, respState *tfsdk.State, diagnostics *diag.Diagnostics, err error, operation string) {
 	if err != nil {
 		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
@@ -0,0 +1,147 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// newServiceKeyTestResource returns a serviceKey whose API calls are answered by the given
+// responses, keyed by GraphQL field, and records the fields that were called.
+func newServiceKeyTestResource(t *testing.T, responses map[string]string) (*serviceKey, *[]string) {
+	t.Helper()
+
+	apiClient := client.NewClient(t.Context(), "https://test.twindev.com", "xxxx", time.Second, 0, client.DefaultAgent, "test", client.CacheOptions{})
+	httpmock.ActivateNonDefault(apiClient.HTTPClient)
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	var calls []string
+
+	httpmock.RegisterResponder("POST", apiClient.GraphqlServerURL, func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+
+		for field, response := range responses {
+			if strings.Contains(string(body), field+"(") {
+				calls = append(calls, field)
+
+				return httpmock.NewStringResponse(http.StatusOK, response), nil
+			}
+		}
+
+		return nil, errors.New("unexpected request: " + string(body))
+	})
+
+	return &serviceKey{client: apiClient}, &calls
+}
+
+func TestServiceKeyRotateKeepsOldKeyWhenDeliveryFails(t *testing.T) {
+	ctx := context.Background()
+
+	r, calls := newServiceKeyTestResource(t, map[string]string{
+		"serviceAccountKeyCreate": `{"data":{"serviceAccountKeyCreate":{"ok":true,"token":"new-token","entity":{"id":"new-key","name":"key","status":"ACTIVE","serviceAccount":{"id":"sa-1"}}}}}`,
+		"serviceAccountKey":       `{"data":{"serviceAccountKey":{"id":"new-key","name":"key","status":"ACTIVE","serviceAccount":{"id":"sa-1"}}}}`,
+		"serviceAccountKeyRevoke": `{"data":{"serviceAccountKeyRevoke":{"ok":true}}}`,
+		"serviceAccountKeyDelete": `{"data":{"serviceAccountKeyDelete":{"ok":true}}}`,
+	})
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	keySchema := schemaResp.Schema
+	secretSinkType, _ := keySchema.Attributes[attr.SecretSink].GetType().(types.ObjectType)
+
+	secretSink := types.ObjectValueMust(secretSinkType.AttrTypes, map[string]tfattr.Value{
+		attr.File:    types.StringValue(filepath.Join(t.TempDir(), "missing", "token.json")),
+		attr.Command: types.ListNull(types.StringType),
+		attr.HTTP:    types.ObjectNull(secretSinkType.AttrTypes[attr.HTTP].(types.ObjectType).AttrTypes),
+	})
+
+	prior := serviceKeyModel{
+		ID:                 types.StringValue("old-key"),
+		ServiceAccountID:   types.StringValue("sa-1"),
+		Name:               types.StringValue("key"),
+		Token:              types.StringNull(),
+		IsActive:           types.BoolValue(true),
+		ExpirationTime:     types.Int64Value(0),
+		RotationPeriod:     types.StringValue("30d"),
+		RotateBeforeExpiry: types.StringNull(),
+		SecretSink:         secretSink,
+		TokenSHA256:        secretHash("old-token"),
+		Timeouts:           nullTimeouts(ctx),
+	}
+
+	planned := prior
+	planned.ID = types.StringUnknown()
+	planned.Token = types.StringUnknown()
+	planned.TokenSHA256 = types.StringUnknown()
+	planned.IsActive = types.BoolUnknown()
+
+	state := tfsdk.State{Schema: keySchema}
+	assert.False(t, state.Set(ctx, &prior).HasError())
+
+	plan := tfsdk.Plan{Schema: keySchema}
+	assert.False(t, plan.Set(ctx, &planned).HasError())
+
+	config := prior
+	config.ID = types.StringNull()
+	config.TokenSHA256 = types.StringNull()
+	config.IsActive = types.BoolNull()
+
+	configValue := tfsdk.State{Schema: keySchema}
+	assert.False(t, configValue.Set(ctx, &config).HasError())
+
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: keySchema, Raw: plan.Raw}}
+	r.Update(ctx, resource.UpdateRequest{
+		Config: tfsdk.Config{Schema: keySchema, Raw: configValue.Raw},
+		Plan:   plan,
+		State:  state,
+	}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+
+	var actual serviceKeyModel
+	assert.False(t, resp.State.Get(ctx, &actual).HasError())
+	assert.Equal(t, prior, actual)
+
+	// the undelivered key is removed again, the old one is left alone
+	assert.Equal(t, []string{"serviceAccountKeyCreate", "serviceAccountKey", "serviceAccountKeyRevoke", "serviceAccountKeyDelete"}, *calls)
+}
+
+func TestServiceKeyRetireServiceKeysKeepsFailedKeys(t *testing.T) {
+	ctx := context.Background()
+
+	r, _ := newServiceKeyTestResource(t, map[string]string{
+		"serviceAccountKey":       `{"data":{"serviceAccountKey":{"id":"old-key","name":"key","status":"ACTIVE","serviceAccount":{"id":"sa-1"}}}}`,
+		"serviceAccountKeyRevoke": `{"data":{"serviceAccountKeyRevoke":{"ok":false,"error":"failed"}}}`,
+	})
+
+	private := testPrivateState{}
+	assert.False(t, setRetiredIDs(ctx, private, []string{"old-key"}).HasError())
+
+	retiredIDs, diags := getRetiredIDs(ctx, private)
+	assert.False(t, diags.HasError())
+
+	var diagnostics diag.Diagnostics
+	r.retireServiceKeys(ctx, retiredIDs, private, &diagnostics)
+
+	assert.True(t, diagnostics.HasError())
+
+	retiredIDs, diags = getRetiredIDs(ctx, private)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []string{"old-key"}, retiredIDs)
+}
@@ -3,23 +3,37 @@ package resource
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
+const sshCAPrivateKey = "private_key"
+
+var ErrSSHCAPrivateKeyNotDelivered = errors.New("a generated private key must be written to `generate_key.private_key_file` or delivered to `secret_sink`")
+
 // Ensure the implementation satisfies the desired interfaces.
-var _ resource.Resource = &sshCertificateAuthority{}
+var (
+	_ resource.Resource                   = &sshCertificateAuthority{}
+	_ resource.ResourceWithValidateConfig = &sshCertificateAuthority{}
+)
 
 func NewSSHCertificateAuthorityResource() resource.Resource {
 	return &sshCertificateAuthority{}
@@ -30,10 +44,20 @@ type sshCertificateAuthority struct {
 }
 
 type sshCertificateAuthorityModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	PublicKey   types.String `tfsdk:"public_key"`
-	Fingerprint types.String `tfsdk:"fingerprint"`
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	PublicKey          types.String   `tfsdk:"public_key"`
+	Fingerprint        types.String   `tfsdk:"fingerprint"`
+	OpenSSHFingerprint types.String   `tfsdk:"openssh_fingerprint"`
+	GenerateKey        types.Object   `tfsdk:"generate_key"`
+	SecretSink         types.Object   `tfsdk:"secret_sink"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+type sshGenerateKeyModel struct {
+	Type           types.String `tfsdk:"type"`
+	PrivateKeyFile types.String `tfsdk:"private_key_file"`
 }
 
 func (r *sshCertificateAuthority) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -57,7 +81,7 @@ func (r *sshCertificateAuthority) ImportState(ctx context.Context, req resource.
 	resource.ImportStatePassthroughID(ctx, path.Root(attr.ID), req, resp)
 }
 
-func (r *sshCertificateAuthority) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *sshCertificateAuthority) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "SSH Certificate Authorities allow Twingate to sign SSH certificates for authenticating users to resources.",
 		Attributes: map[string]schema.Attribute{
@@ -76,16 +100,54 @@ func (r *sshCertificateAuthority) Schema(_ context.Context, _ resource.SchemaReq
 				},
 			},
 			attr.PublicKey: schema.StringAttribute{
-				Required:    true,
-				Description: "The SSH public key in OpenSSH authorized_keys format.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The SSH public key in OpenSSH authorized_keys format. Exactly one of `public_key` or `generate_key` must be set.",
 				PlanModifiers: []planmodifier.String{
+					// a generated key is computed, keep it first so unrelated changes don't replace the CA
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot(attr.GenerateKey)),
+				},
 			},
+			attr.GenerateKey: schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Generates the key pair in the provider and registers its public half. The private key is never kept in state: it is written to `private_key_file`, from where the `twingate_ssh_certificate_authority_key` ephemeral resource reads it for `twingate_gateway_config`'s `ssh.ca.private_key_file`, and/or delivered to `secret_sink`.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					attr.Type: schema.StringAttribute{
+						Required:    true,
+						Description: fmt.Sprintf("The key type, one of %s. ECDSA keys use the P-256 curve and RSA keys are 4096 bits.", utils.DocList([]string{utils.SSHKeyTypeEd25519, utils.SSHKeyTypeECDSA, utils.SSHKeyTypeRSA})),
+						Validators: []validator.String{
+							stringvalidator.OneOf(utils.SSHKeyTypeEd25519, utils.SSHKeyTypeECDSA, utils.SSHKeyTypeRSA),
+						},
+					},
+					attr.PrivateKeyFile: schema.StringAttribute{
+						Optional:    true,
+						Description: "Path the private key is written to in OpenSSH format with `0600` permissions.",
+					},
+				},
+			},
+			attr.SecretSink:         sshCASecretSinkAttribute(),
+			attr.DeletionProtection: deletionProtectionAttribute(),
 			attr.Fingerprint: schema.StringAttribute{
 				Computed:    true,
 				Description: "The fingerprint of the SSH public key.",
 			},
+			attr.OpenSSHFingerprint: schema.StringAttribute{
+				Computed:    true,
+				Description: "The OpenSSH SHA256 fingerprint of the public key, as printed by `ssh-keygen -l`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			attr.Timeouts: timeoutsBlock(ctx),
 		},
 	}
 }
@@ -95,20 +157,99 @@ func (r *sshCertificateAuthority) Create(ctx context.Context, req resource.Creat
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if !plan.GenerateKey.IsNull() {
+		resp.Diagnostics.Append(generateSSHCAKey(ctx, req.Config, &plan)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	ca, err := r.client.CreateSSHCertificateAuthority(ctx, plan.Name.ValueString(), plan.PublicKey.ValueString())
 
 	r.helper(ctx, ca, &plan, &resp.State, &resp.Diagnostics, err, operationCreate)
 }
 
+// generateSSHCAKey creates the key pair, hands the private key over and keeps the public key in the plan.
+func generateSSHCAKey(ctx context.Context, config tfsdk.Config, plan *sshCertificateAuthorityModel) diag.Diagnostics {
+	var (
+		diags       diag.Diagnostics
+		generateKey sshGenerateKeyModel
+	)
+
+	diags.Append(plan.GenerateKey.As(ctx, &generateKey, basetypes.ObjectAsOptions{})...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	publicKey, privateKey, err := utils.GenerateSSHKeyPair(generateKey.Type.ValueString(), plan.Name.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root(attr.GenerateKey), "failed to generate SSH key pair", err.Error())
+
+		return diags
+	}
+
+	if privateKeyFile := generateKey.PrivateKeyFile.ValueString(); privateKeyFile != "" {
+		if err := writeSecretFile(privateKeyFile, []byte(privateKey)); err != nil {
+			diags.AddAttributeError(path.Root(attr.GenerateKey).AtName(attr.PrivateKeyFile), "failed to write private key", err.Error())
+
+			return diags
+		}
+	}
+
+	if isSecretSinkSet(plan.SecretSink) {
+		diags.Append(deliverSecrets(ctx, config, map[string]string{
+			sshCAPrivateKey: privateKey,
+			attr.PublicKey:  publicKey,
+		})...)
+	}
+
+	plan.PublicKey = types.StringValue(publicKey)
+
+	return diags
+}
+
+func sshCASecretSinkAttribute() schema.SingleNestedAttribute {
+	sink := secretSinkAttribute()
+	sink.Description = "Delivers the generated key pair to the configured sink as a JSON object with `private_key` and `public_key`. Only used with `generate_key`. Exactly one of `file`, `command` or `http` must be set. Changing the destination re-creates the resource."
+
+	return sink
+}
+
+func (r *sshCertificateAuthority) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config sshCertificateAuthorityModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() || config.GenerateKey.IsNull() || config.GenerateKey.IsUnknown() || !config.SecretSink.IsNull() {
+		return
+	}
+
+	var generateKey sshGenerateKeyModel
+
+	resp.Diagnostics.Append(config.GenerateKey.As(ctx, &generateKey, basetypes.ObjectAsOptions{})...)
+
+	if !resp.Diagnostics.HasError() && generateKey.PrivateKeyFile.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root(attr.GenerateKey), "Missing private key destination", ErrSSHCAPrivateKeyNotDelivered.Error())
+	}
+}
+
 func (r *sshCertificateAuthority) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state sshCertificateAuthorityModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -118,8 +259,24 @@ func (r *sshCertificateAuthority) Read(ctx context.Context, req resource.ReadReq
 	r.helper(ctx, ca, &state, &resp.State, &resp.Diagnostics, err, operationRead)
 }
 
-func (r *sshCertificateAuthority) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
-	// All mutable fields trigger replacement, so Update is never called.
+func (r *sshCertificateAuthority) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All other mutable fields trigger replacement, so only deletion_protection can change here.
+	var plan, state sshCertificateAuthorityModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.DeletionProtection = plan.DeletionProtection
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *sshCertificateAuthority) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -127,14 +284,31 @@ func (r *sshCertificateAuthority) Delete(ctx context.Context, req resource.Delet
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if isDeletionProtected(state.DeletionProtection, &resp.Diagnostics, TwingateSSHCertificateAuthority) {
+		return
+	}
+
 	err := r.client.DeleteSSHCertificateAuthority(ctx, state.ID.ValueString())
 	addErr(&resp.Diagnostics, err, operationDelete, TwingateSSHCertificateAuthority)
 }
 
+// sshPublicKeyFingerprint is null for imported Certificate Authorities, whose public key isn't known.
+func sshPublicKeyFingerprint(publicKey types.String) types.String {
+	fingerprint, err := utils.CalculateSSHPublicKeyFingerprint(publicKey.ValueString())
+	if err != nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(fingerprint)
+}
+
 func (r *sshCertificateAuthority) helper(ctx context.Context, certificateAuthority *model.CertificateAuthority, state *sshCertificateAuthorityModel, respState *tfsdk.State, diagnostics *diag.Diagnostics, err error, operation string) {
 	if err != nil {
 		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
@@ -151,7 +325,9 @@ func (r *sshCertificateAuthority) helper(ctx context.Context, certificateAuthori
 	state.ID = types.StringValue(certificateAuthority.ID)
 	state.Name = types.StringValue(certificateAuthority.Name)
 	state.Fingerprint = types.StringValue(certificateAuthority.Fingerprint)
+	state.DeletionProtection = deletionProtectionValue(state.DeletionProtection)
 	// PublicKey is not returned by the API; preserve the value already in state.
+	state.OpenSSHFingerprint = sshPublicKeyFingerprint(state.PublicKey)
 
 	diags := respState.Set(ctx, state)
 	diagnostics.Append(diags...)
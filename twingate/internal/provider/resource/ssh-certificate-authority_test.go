@@ -0,0 +1,81 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSHCertificateAuthorityPublicKeyPlanOnDeletionProtectionChange(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := resource.SchemaResponse{}
+	NewSSHCertificateAuthorityResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	assert.False(t, schemaResp.Diagnostics.HasError())
+
+	caSchema := schemaResp.Schema
+
+	generateKeyType, _ := caSchema.Attributes[attr.GenerateKey].GetType().(types.ObjectType)
+	secretSinkType, _ := caSchema.Attributes[attr.SecretSink].GetType().(types.ObjectType)
+
+	prior := sshCertificateAuthorityModel{
+		ID:                 types.StringValue("ca-id"),
+		Name:               types.StringValue("ca"),
+		PublicKey:          types.StringValue("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGeneratedKey"),
+		Fingerprint:        types.StringValue("fingerprint"),
+		OpenSSHFingerprint: types.StringValue("SHA256:fingerprint"),
+		GenerateKey: types.ObjectValueMust(generateKeyType.AttrTypes, map[string]tfattr.Value{
+			attr.Type:           types.StringValue("ed25519"),
+			attr.PrivateKeyFile: types.StringValue("/tmp/ca"),
+		}),
+		SecretSink:         types.ObjectNull(secretSinkType.AttrTypes),
+		DeletionProtection: types.BoolValue(false),
+		Timeouts:           nullTimeouts(ctx),
+	}
+
+	// the framework marks computed attributes unknown when any other attribute changes
+	planned := prior
+	planned.PublicKey = types.StringUnknown()
+	planned.DeletionProtection = types.BoolValue(true)
+
+	state := tfsdk.State{Schema: caSchema}
+	assert.False(t, state.Set(ctx, &prior).HasError())
+
+	plan := tfsdk.Plan{Schema: caSchema}
+	assert.False(t, plan.Set(ctx, &planned).HasError())
+
+	config := tfsdk.Config{Schema: caSchema, Raw: plan.Raw}
+
+	publicKey, _ := caSchema.Attributes[attr.PublicKey].(schema.StringAttribute)
+
+	req := planmodifier.StringRequest{
+		Path:        path.Root(attr.PublicKey),
+		Config:      config,
+		ConfigValue: types.StringNull(),
+		Plan:        plan,
+		PlanValue:   planned.PublicKey,
+		State:       state,
+		StateValue:  prior.PublicKey,
+	}
+
+	for _, modifier := range publicKey.PlanModifiers {
+		resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+		modifier.PlanModifyString(ctx, req, resp)
+
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.False(t, resp.RequiresReplace, "changing deletion_protection must not replace the CA")
+
+		req.PlanValue = resp.PlanValue
+	}
+
+	assert.Equal(t, prior.PublicKey, req.PlanValue)
+}
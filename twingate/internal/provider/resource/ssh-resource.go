@@ -12,6 +12,7 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -37,19 +38,20 @@ type sshResource struct {
 }
 
 type sshResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	Address          types.String `tfsdk:"address"`
-	GatewayID        types.String `tfsdk:"gateway_id"`
-	RemoteNetworkID  types.String `tfsdk:"remote_network_id"`
-	Username         types.String `tfsdk:"username"`
-	IsVisible        types.Bool   `tfsdk:"is_visible"`
-	Alias            types.String `tfsdk:"alias"`
-	SecurityPolicyID types.String `tfsdk:"security_policy_id"`
-	Tags             types.Map    `tfsdk:"tags"`
-	Protocols        types.Object `tfsdk:"protocols"`
-	AccessPolicy     types.Set    `tfsdk:"access_policy"`
-	GroupAccess      types.Set    `tfsdk:"access_group"`
+	ID               types.String   `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	Address          types.String   `tfsdk:"address"`
+	GatewayID        types.String   `tfsdk:"gateway_id"`
+	RemoteNetworkID  types.String   `tfsdk:"remote_network_id"`
+	Username         types.String   `tfsdk:"username"`
+	IsVisible        types.Bool     `tfsdk:"is_visible"`
+	Alias            types.String   `tfsdk:"alias"`
+	SecurityPolicyID types.String   `tfsdk:"security_policy_id"`
+	Tags             types.Map      `tfsdk:"tags"`
+	Protocols        types.Object   `tfsdk:"protocols"`
+	AccessPolicy     types.Set      `tfsdk:"access_policy"`
+	GroupAccess      types.Set      `tfsdk:"access_group"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *sshResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -74,7 +76,7 @@ func (r *sshResource) ImportState(ctx context.Context, req resource.ImportStateR
 }
 
 //nolint:funlen
-func (r *sshResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *sshResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "SSH Resources are Twingate resources accessed via a Gateway.",
 		Attributes: map[string]schema.Attribute{
@@ -143,6 +145,7 @@ func (r *sshResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 			attr.Protocols: protocols(),
 		},
 		Blocks: map[string]schema.Block{
+			attr.Timeouts:     timeoutsBlock(ctx),
 			attr.AccessPolicy: accessPolicyBlock(),
 			attr.AccessGroup:  groupAccessBlock(),
 		},
@@ -154,6 +157,9 @@ func (r *sshResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -201,6 +207,9 @@ func (r *sshResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -215,6 +224,9 @@ func (r *sshResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state sshResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -299,6 +311,9 @@ func (r *sshResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -36,14 +37,15 @@ type user struct {
 }
 
 type userModel struct {
-	ID         types.String `tfsdk:"id"`
-	Email      types.String `tfsdk:"email"`
-	FirstName  types.String `tfsdk:"first_name"`
-	LastName   types.String `tfsdk:"last_name"`
-	IsActive   types.Bool   `tfsdk:"is_active"`
-	Role       types.String `tfsdk:"role"`
-	Type       types.String `tfsdk:"type"`
-	SendInvite types.Bool   `tfsdk:"send_invite"`
+	ID         types.String   `tfsdk:"id"`
+	Email      types.String   `tfsdk:"email"`
+	FirstName  types.String   `tfsdk:"first_name"`
+	LastName   types.String   `tfsdk:"last_name"`
+	IsActive   types.Bool     `tfsdk:"is_active"`
+	Role       types.String   `tfsdk:"role"`
+	Type       types.String   `tfsdk:"type"`
+	SendInvite types.Bool     `tfsdk:"send_invite"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *user) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,10 +66,17 @@ func (r *user) Configure(_ context.Context, req resource.ConfigureRequest, _ *re
 }
 
 func (r *user) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root(attr.ID), req, resp)
+	id, err := resolveUserImportID(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to import state", err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.ID), id)...)
 }
 
-func (r *user) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *user) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Users provides different levels of write capabilities across the Twingate Admin Console. For more information, see Twingate's [documentation](https://www.twingate.com/docs/users).",
 		Attributes: map[string]schema.Attribute{
@@ -128,6 +137,9 @@ func (r *user) Schema(_ context.Context, _ resource.SchemaRequest, resp *resourc
 				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			attr.Timeouts: timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -136,6 +148,9 @@ func (r *user) Create(ctx context.Context, req resource.CreateRequest, resp *res
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -165,6 +180,9 @@ func (r *user) Read(ctx context.Context, req resource.ReadRequest, resp *resourc
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -180,6 +198,9 @@ func (r *user) Update(ctx context.Context, req resource.UpdateRequest, resp *res
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -206,6 +227,7 @@ func (r *user) Update(ctx context.Context, req resource.UpdateRequest, resp *res
 	}
 
 	state.SendInvite = plan.SendInvite
+	state.Timeouts = plan.Timeouts
 
 	user, err := r.client.UpdateUser(ctx, userUpdateReq)
 
@@ -217,6 +239,9 @@ func (r *user) Delete(ctx context.Context, req resource.DeleteRequest, resp *res
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -2,8 +2,10 @@ package resource
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
@@ -11,6 +13,7 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const defaultCertificateExpiryWarning = "30d"
+
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.Resource = &x509CertificateAuthority{}
 var _ resource.ResourceWithModifyPlan = &x509CertificateAuthority{}
@@ -35,10 +40,19 @@ type x509CertificateAuthority struct {
 }
 
 type x509CertificateAuthorityModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Certificate types.String `tfsdk:"certificate"`
-	Fingerprint types.String `tfsdk:"fingerprint"`
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Certificate        types.String   `tfsdk:"certificate"`
+	Fingerprint        types.String   `tfsdk:"fingerprint"`
+	Subject            types.String   `tfsdk:"subject"`
+	Issuer             types.String   `tfsdk:"issuer"`
+	NotBefore          types.String   `tfsdk:"not_before"`
+	NotAfter           types.String   `tfsdk:"not_after"`
+	Serial             types.String   `tfsdk:"serial"`
+	KeyAlgorithm       types.String   `tfsdk:"key_algorithm"`
+	ExpiryWarning      types.String   `tfsdk:"expiry_warning"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *x509CertificateAuthority) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -62,7 +76,7 @@ func (r *x509CertificateAuthority) ImportState(ctx context.Context, req resource
 	resource.ImportStatePassthroughID(ctx, path.Root(attr.ID), req, resp)
 }
 
-func (r *x509CertificateAuthority) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *x509CertificateAuthority) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "X509 Certificate Authorities allow Twingate to verify certificates presented by resources during TLS connections.",
 		Attributes: map[string]schema.Attribute{
@@ -83,15 +97,48 @@ func (r *x509CertificateAuthority) Schema(_ context.Context, _ resource.SchemaRe
 			attr.Certificate: schema.StringAttribute{
 				Required:    true,
 				WriteOnly:   true,
-				Description: "The PEM-encoded X509 certificate. This field is write-only and will not be returned by the API.",
+				Description: "The PEM-encoded X509 certificate, or a chain or bundle of certificates. Every certificate must be a CA allowed to sign certificates, and a new certificate must be within its validity period. Once in use, an expiring certificate only produces a warning. The metadata attributes describe the first certificate. This field is write-only and will not be returned by the API.",
 				Validators: []validator.String{
 					customvalidator.Certificate(),
 				},
 			},
+			attr.ExpiryWarning: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("How long before a certificate of the chain expires plans start warning about it, e.g. `90d`. Default is `%s`.", defaultCertificateExpiryWarning),
+				Validators:  []validator.String{customvalidator.Duration()},
+			},
+			attr.DeletionProtection: deletionProtectionAttribute(),
 			attr.Fingerprint: schema.StringAttribute{
 				Computed:    true,
 				Description: "The SHA-256 fingerprint of the X509 certificate.",
 			},
+			attr.Subject: schema.StringAttribute{
+				Computed:    true,
+				Description: "The subject of the certificate, e.g. `CN=My CA,O=Example`.",
+			},
+			attr.Issuer: schema.StringAttribute{
+				Computed:    true,
+				Description: "The issuer of the certificate.",
+			},
+			attr.NotBefore: schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the certificate becomes valid, in RFC 3339 format.",
+			},
+			attr.NotAfter: schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the certificate expires, in RFC 3339 format.",
+			},
+			attr.Serial: schema.StringAttribute{
+				Computed:    true,
+				Description: "The serial number of the certificate as colon-separated hex pairs.",
+			},
+			attr.KeyAlgorithm: schema.StringAttribute{
+				Computed:    true,
+				Description: "The algorithm and size of the certificate's public key, e.g. `RSA-2048`, `ECDSA-P-256` or `Ed25519`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			attr.Timeouts: timeoutsBlock(ctx),
 		},
 	}
 }
@@ -101,6 +148,9 @@ func (r *x509CertificateAuthority) Create(ctx context.Context, req resource.Crea
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -124,6 +174,9 @@ func (r *x509CertificateAuthority) Read(ctx context.Context, req resource.ReadRe
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -133,8 +186,27 @@ func (r *x509CertificateAuthority) Read(ctx context.Context, req resource.ReadRe
 	r.helper(ctx, ca, &state, &resp.State, &resp.Diagnostics, err, operationRead)
 }
 
-func (r *x509CertificateAuthority) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
-	// All mutable fields trigger replacement, so Update is never called.
+func (r *x509CertificateAuthority) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All other mutable fields trigger replacement, so only deletion_protection, expiry_warning
+	// and the certificate metadata (e.g. after an import) can change here.
+	var plan, state x509CertificateAuthorityModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.DeletionProtection = plan.DeletionProtection
+	state.ExpiryWarning = plan.ExpiryWarning
+	state.Timeouts = plan.Timeouts
+	setCertificateMetadata(&state, plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *x509CertificateAuthority) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -142,10 +214,17 @@ func (r *x509CertificateAuthority) Delete(ctx context.Context, req resource.Dele
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if isDeletionProtected(state.DeletionProtection, &resp.Diagnostics, TwingateX509CertificateAuthority) {
+		return
+	}
+
 	err := r.client.DeleteX509CertificateAuthority(ctx, state.ID.ValueString())
 	addErr(&resp.Diagnostics, err, operationDelete, TwingateX509CertificateAuthority)
 }
@@ -167,6 +246,7 @@ func (r *x509CertificateAuthority) helper(ctx context.Context, certificateAuthor
 	state.Name = types.StringValue(certificateAuthority.Name)
 	// instead of a certificate - we store its fingerprint
 	state.Fingerprint = types.StringValue(certificateAuthority.Fingerprint)
+	state.DeletionProtection = deletionProtectionValue(state.DeletionProtection)
 
 	diags := respState.Set(ctx, state)
 	diagnostics.Append(diags...)
@@ -175,29 +255,24 @@ func (r *x509CertificateAuthority) helper(ctx context.Context, certificateAuthor
 // ModifyPlan triggers resource replacement when the certificate content changes.
 // It reads the certificate from config (write-only values are unavailable in the plan),
 // computes its fingerprint, and compares it with the fingerprint stored in state.
+// It also fills in the certificate metadata, rejects a new certificate that is expired or
+// not yet valid, and warns when the certificate expires soon.
 func (r *x509CertificateAuthority) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	// Skip on create (state is null) or delete (plan is null).
-	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
-		return
-	}
-
-	var stateFingerprint types.String
-
-	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(attr.Fingerprint), &stateFingerprint)...)
-
-	if resp.Diagnostics.HasError() || stateFingerprint.IsNull() || stateFingerprint.IsUnknown() {
+	// Skip on delete (plan is null).
+	if req.Plan.Raw.IsNull() {
 		return
 	}
 
-	var certValue types.String
+	var config, plan x509CertificateAuthorityModel
 
-	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.Certificate), &certValue)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
-	if resp.Diagnostics.HasError() || certValue.IsNull() || certValue.IsUnknown() {
+	if resp.Diagnostics.HasError() || config.Certificate.IsNull() || config.Certificate.IsUnknown() {
 		return
 	}
 
-	newFingerprint, err := utils.CalculateCertificateFingerprint(certValue.ValueString())
+	certs, err := utils.ParseCertificates(config.Certificate.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddAttributeError(
 			path.Root(attr.Certificate),
@@ -208,7 +283,90 @@ func (r *x509CertificateAuthority) ModifyPlan(ctx context.Context, req resource.
 		return
 	}
 
-	if stateFingerprint.ValueString() != newFingerprint {
+	info := utils.NewCertificateInfo(certs[0])
+	setCertificateInfo(&plan, info)
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+
+	changed, diags := certificateChanged(ctx, req.State, info.Fingerprint)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if changed && !req.State.Raw.IsNull() {
 		resp.RequiresReplace = append(resp.RequiresReplace, path.Root(attr.Certificate))
 	}
+
+	// a new certificate must be valid, one already in use only gets the expiry warning
+	if changed {
+		if err := utils.ValidateCertificatesValidity(certs, time.Now()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attr.Certificate), "Invalid certificate", err.Error())
+
+			return
+		}
+	}
+
+	warnCertificateExpiry(certs, config.ExpiryWarning, &resp.Diagnostics)
+}
+
+// certificateChanged reports whether the certificate is new, either on create or when its
+// fingerprint differs from the one in state.
+func certificateChanged(ctx context.Context, state tfsdk.State, fingerprint string) (bool, diag.Diagnostics) {
+	if state.Raw.IsNull() {
+		return true, nil
+	}
+
+	var stateFingerprint types.String
+
+	diags := state.GetAttribute(ctx, path.Root(attr.Fingerprint), &stateFingerprint)
+	if diags.HasError() || stateFingerprint.IsNull() || stateFingerprint.IsUnknown() {
+		return false, diags
+	}
+
+	return stateFingerprint.ValueString() != fingerprint, diags
+}
+
+// setCertificateInfo sets the metadata computed from the certificate. The fingerprint
+// is left to the API.
+func setCertificateInfo(model *x509CertificateAuthorityModel, info utils.CertificateInfo) {
+	model.Subject = types.StringValue(info.Subject)
+	model.Issuer = types.StringValue(info.Issuer)
+	model.NotBefore = types.StringValue(info.NotBefore.Format(time.RFC3339))
+	model.NotAfter = types.StringValue(info.NotAfter.Format(time.RFC3339))
+	model.Serial = types.StringValue(info.Serial)
+	model.KeyAlgorithm = types.StringValue(info.KeyAlgorithm)
+}
+
+func setCertificateMetadata(model *x509CertificateAuthorityModel, from x509CertificateAuthorityModel) {
+	model.Subject = from.Subject
+	model.Issuer = from.Issuer
+	model.NotBefore = from.NotBefore
+	model.NotAfter = from.NotAfter
+	model.Serial = from.Serial
+	model.KeyAlgorithm = from.KeyAlgorithm
+}
+
+// warnCertificateExpiry warns about the certificates of the chain that expire within the warning period.
+func warnCertificateExpiry(certs []*x509.Certificate, expiryWarning types.String, diagnostics *diag.Diagnostics) {
+	period := withDefaultValue(expiryWarning.ValueString(), defaultCertificateExpiryWarning)
+
+	duration, err := utils.ParseDurationWithDays(period)
+	if err != nil {
+		// already reported by the attribute validator
+		return
+	}
+
+	deadline := time.Now().Add(duration)
+
+	for _, cert := range certs {
+		if cert.NotAfter.Before(deadline) {
+			diagnostics.AddAttributeWarning(
+				path.Root(attr.Certificate),
+				"Certificate expires soon",
+				fmt.Sprintf("The certificate %q expires on %s, within %s. Replace it before it expires.", cert.Subject, cert.NotAfter.UTC().Format(time.RFC3339), period),
+			)
+		}
+	}
 }
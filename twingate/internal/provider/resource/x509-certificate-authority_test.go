@@ -0,0 +1,147 @@
+package resource
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWarnCertificateExpiry(t *testing.T) {
+	certs := []*x509.Certificate{
+		{Subject: pkix.Name{CommonName: "Intermediate"}, NotAfter: time.Now().Add(10 * 24 * time.Hour)},
+		{Subject: pkix.Name{CommonName: "Root"}, NotAfter: time.Now().Add(100 * 24 * time.Hour)},
+	}
+
+	cases := []struct {
+		expiryWarning    types.String
+		expectedWarnings int
+	}{
+		{expiryWarning: types.StringNull(), expectedWarnings: 1},
+		{expiryWarning: types.StringValue("1d"), expectedWarnings: 0},
+		{expiryWarning: types.StringValue("365d"), expectedWarnings: 2},
+		{expiryWarning: types.StringValue("invalid"), expectedWarnings: 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.expiryWarning.String(), func(t *testing.T) {
+			var diags diag.Diagnostics
+
+			warnCertificateExpiry(certs, c.expiryWarning, &diags)
+
+			assert.False(t, diags.HasError())
+			assert.Equal(t, c.expectedWarnings, diags.WarningsCount())
+		})
+	}
+}
+
+func TestX509CertificateAuthorityModifyPlanExpiredCertificate(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := resource.SchemaResponse{}
+	NewX509CertificateAuthorityResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	assert.False(t, schemaResp.Diagnostics.HasError())
+
+	caSchema := schemaResp.Schema
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Expired"},
+		NotBefore:             time.Now().Add(-48 * time.Hour),
+		NotAfter:              time.Now().Add(-time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	certificate := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	fingerprint, err := utils.CalculateCertificateFingerprint(certificate)
+	assert.NoError(t, err)
+
+	configured := x509CertificateAuthorityModel{
+		ID:                 types.StringUnknown(),
+		Name:               types.StringValue("ca"),
+		Certificate:        types.StringValue(certificate),
+		Fingerprint:        types.StringUnknown(),
+		Subject:            types.StringUnknown(),
+		Issuer:             types.StringUnknown(),
+		NotBefore:          types.StringUnknown(),
+		NotAfter:           types.StringUnknown(),
+		Serial:             types.StringUnknown(),
+		KeyAlgorithm:       types.StringUnknown(),
+		ExpiryWarning:      types.StringNull(),
+		DeletionProtection: types.BoolValue(false),
+		Timeouts:           nullTimeouts(ctx),
+	}
+
+	existing := configured
+	existing.ID = types.StringValue("ca-id")
+	existing.Certificate = types.StringNull()
+	existing.Fingerprint = types.StringValue(fingerprint)
+	existing.Subject = types.StringValue("CN=Expired")
+	existing.Issuer = types.StringValue("CN=Expired")
+	existing.NotBefore = types.StringValue(template.NotBefore.UTC().Format(time.RFC3339))
+	existing.NotAfter = types.StringValue(template.NotAfter.UTC().Format(time.RFC3339))
+	existing.Serial = types.StringValue("01")
+	existing.KeyAlgorithm = types.StringValue("ECDSA-P-256")
+
+	nullState := tfsdk.State{Schema: caSchema, Raw: tftypes.NewValue(caSchema.Type().TerraformType(ctx), nil)}
+
+	existingState := tfsdk.State{Schema: caSchema}
+	assert.False(t, existingState.Set(ctx, &existing).HasError())
+
+	cases := []struct {
+		name          string
+		state         tfsdk.State
+		expectedError bool
+	}{
+		{name: "create", state: nullState, expectedError: true},
+		{name: "unchanged certificate", state: existingState, expectedError: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			planned := configured
+			planned.Certificate = types.StringNull()
+
+			plan := tfsdk.Plan{Schema: caSchema}
+			assert.False(t, plan.Set(ctx, &planned).HasError())
+
+			config := tfsdk.Config{Schema: caSchema}
+			configPlan := tfsdk.Plan{Schema: caSchema}
+			assert.False(t, configPlan.Set(ctx, &configured).HasError())
+			config.Raw = configPlan.Raw
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			(&x509CertificateAuthority{}).ModifyPlan(ctx, resource.ModifyPlanRequest{Config: config, Plan: plan, State: c.state}, resp)
+
+			assert.Equal(t, c.expectedError, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Empty(t, resp.RequiresReplace)
+
+			if !c.expectedError {
+				assert.Equal(t, 1, resp.Diagnostics.WarningsCount())
+			}
+		})
+	}
+}
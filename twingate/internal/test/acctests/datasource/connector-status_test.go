@@ -0,0 +1,79 @@
+package datasource
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/acctests"
+	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatasourceTwingateConnectorStatus_noWait(t *testing.T) {
+	t.Parallel()
+
+	networkName := test.RandomName()
+	connectorName := test.RandomConnectorName()
+	theDatasource := "data.twingate_connector_status.test_dcst1"
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateConnectorDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: testDatasourceTwingateConnectorStatus(networkName, connectorName, "wait = false"),
+				Check: acctests.ComposeTestCheckFunc(
+					sdk.TestCheckResourceAttr(theDatasource, attr.Ready, "false"),
+					sdk.TestCheckResourceAttr(theDatasource, attr.Len(attr.Connectors), "1"),
+					sdk.TestCheckResourceAttr(theDatasource, attr.Path(attr.Connectors, attr.Name), connectorName),
+					sdk.TestCheckResourceAttr(theDatasource, attr.Path(attr.Connectors, attr.State), "DEAD_NO_HEARTBEAT"),
+					sdk.TestCheckResourceAttr(theDatasource, attr.Path(attr.Connectors, attr.Ready), "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatasourceTwingateConnectorStatus_notReady(t *testing.T) {
+	t.Parallel()
+
+	networkName := test.RandomName()
+	connectorName := test.RandomConnectorName()
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateConnectorDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: testDatasourceTwingateConnectorStatus(networkName, connectorName, `
+		timeouts {
+			read = "5s"
+		}`),
+				ExpectError: regexp.MustCompile("connectors are not ready"),
+			},
+		},
+	})
+}
+
+func testDatasourceTwingateConnectorStatus(networkName, connectorName, options string) string {
+	return fmt.Sprintf(`
+	resource "twingate_remote_network" "test_dcst1" {
+		name = "%s"
+	}
+	resource "twingate_connector" "test_dcst1" {
+		remote_network_id = twingate_remote_network.test_dcst1.id
+		name = "%s"
+	}
+	data "twingate_connector_status" "test_dcst1" {
+		remote_network_id = twingate_remote_network.test_dcst1.id
+		min_version = "1.0.0"
+		%s
+
+		depends_on = [twingate_connector.test_dcst1]
+	}
+	`, networkName, connectorName, options)
+}
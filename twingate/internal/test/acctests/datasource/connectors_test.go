@@ -334,3 +334,56 @@ func TestAccDatasourceTwingateConnectorsFilterByRegexp(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDatasourceTwingateConnectorsFilterByRemoteNetworkAndState(t *testing.T) {
+	t.Parallel()
+
+	networkName1 := test.RandomName()
+	networkName2 := test.RandomName()
+	connectorName := test.RandomConnectorName()
+	theDatasource := "data.twingate_connectors.by_network"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testDatasourceConnectorsFilterByRemoteNetworkAndState(networkName1, networkName2, connectorName),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(theDatasource, connectorsLen, "1"),
+					resource.TestCheckResourceAttr(theDatasource, connectorNamePath, connectorName+"-1"),
+					resource.TestCheckResourceAttr(theDatasource, attr.StateCounts+".DEAD_NO_HEARTBEAT", "1"),
+					resource.TestCheckResourceAttr(theDatasource, attr.StateCounts+".ALIVE", "0"),
+					resource.TestCheckResourceAttr(theDatasource, attr.OldestVersion, ""),
+				),
+			},
+		},
+	})
+}
+
+func testDatasourceConnectorsFilterByRemoteNetworkAndState(networkName1, networkName2, connectorName string) string {
+	return fmt.Sprintf(`
+	resource "twingate_remote_network" "test_dcs1" {
+		name = "%[1]s"
+	}
+	resource "twingate_connector" "test_dcs1" {
+		remote_network_id = twingate_remote_network.test_dcs1.id
+		name = "%[3]s-1"
+	}
+	resource "twingate_remote_network" "test_dcs2" {
+		name = "%[2]s"
+	}
+	resource "twingate_connector" "test_dcs2" {
+		remote_network_id = twingate_remote_network.test_dcs2.id
+		name = "%[3]s-2"
+	}
+	data "twingate_connectors" "by_network" {
+		name_prefix       = "%[3]s"
+		remote_network_id = twingate_remote_network.test_dcs1.id
+		state             = "DEAD_NO_HEARTBEAT"
+
+		depends_on = [twingate_connector.test_dcs1, twingate_connector.test_dcs2]
+	}
+	`, networkName1, networkName2, connectorName)
+}
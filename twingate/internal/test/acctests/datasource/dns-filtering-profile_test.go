@@ -15,6 +15,7 @@ func TestAccDatasourceTwingateDNSFilteringProfile_basic(t *testing.T) {
 
 	testName := "t" + acctest.RandString(6)
 	profileName := test.RandomName()
+	priority := test.RandomPriority()
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: acctests.ProviderFactories,
@@ -22,10 +23,10 @@ func TestAccDatasourceTwingateDNSFilteringProfile_basic(t *testing.T) {
 		CheckDestroy:             acctests.CheckTwingateConnectorDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testDatasourceTwingateDNSFilteringProfile(testName, profileName),
+				Config: testDatasourceTwingateDNSFilteringProfile(testName, profileName, priority),
 				Check: acctests.ComposeTestCheckFunc(
 					resource.TestCheckOutput("profile_name", profileName),
-					resource.TestCheckOutput("profile_priority", "3"),
+					resource.TestCheckOutput("profile_priority", priority),
 					resource.TestCheckOutput("profile_fallback_method", "AUTO"),
 				),
 			},
@@ -33,11 +34,11 @@ func TestAccDatasourceTwingateDNSFilteringProfile_basic(t *testing.T) {
 	})
 }
 
-func testDatasourceTwingateDNSFilteringProfile(testName, profileName string) string {
+func testDatasourceTwingateDNSFilteringProfile(testName, profileName, priority string) string {
 	return fmt.Sprintf(`
 	resource "twingate_dns_filtering_profile" "%[1]s" {
 	  name = "%[2]s"
-	  priority = 3
+	  priority = %[3]s
 	  fallback_method = "AUTO"
 	}
 
@@ -56,5 +57,64 @@ func testDatasourceTwingateDNSFilteringProfile(testName, profileName string) str
 	output "profile_fallback_method" {
 	  	value = data.twingate_dns_filtering_profile.%[1]s.fallback_method
 	}
-		`, testName, profileName)
+		`, testName, profileName, priority)
+}
+
+func TestAccDatasourceTwingateDNSFilteringProfiles_basic(t *testing.T) {
+	t.Parallel()
+
+	testName := "t" + acctest.RandString(6)
+	profileName := test.RandomName()
+	priority := test.RandomPriority()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateDNSProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testDatasourceTwingateDNSFilteringProfiles(testName, profileName, priority),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckOutput("profile_priority", priority),
+					resource.TestCheckOutput("profile_fallback_method", "AUTO"),
+					resource.TestCheckOutput("profile_group_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testDatasourceTwingateDNSFilteringProfiles(testName, profileName, priority string) string {
+	return fmt.Sprintf(`
+	resource "twingate_group" "%[1]s" {
+	  name = "%[2]s"
+	}
+
+	resource "twingate_dns_filtering_profile" "%[1]s" {
+	  name = "%[2]s"
+	  priority = %[3]s
+	  fallback_method = "AUTO"
+	  groups = [twingate_group.%[1]s.id]
+	}
+
+	data "twingate_dns_filtering_profiles" "%[1]s" {
+	  depends_on = [twingate_dns_filtering_profile.%[1]s]
+	}
+
+	locals {
+	  profile = one([for profile in data.twingate_dns_filtering_profiles.%[1]s.dns_filtering_profiles : profile if profile.id == twingate_dns_filtering_profile.%[1]s.id])
+	}
+
+	output "profile_priority" {
+	  value = local.profile.priority
+	}
+
+	output "profile_fallback_method" {
+	  value = local.profile.fallback_method
+	}
+
+	output "profile_group_count" {
+	  value = local.profile.group_count
+	}
+	`, testName, profileName, priority)
 }
@@ -0,0 +1,94 @@
+package datasource
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/acctests"
+	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func effectiveAccessConfig(terraformName, networkName, serviceAccountName, resourceName string) string {
+	return fmt.Sprintf(`
+	resource "twingate_remote_network" "%[1]s" {
+	  name = "%[2]s"
+	}
+
+	resource "twingate_service_account" "%[1]s" {
+	  name = "%[3]s"
+	}
+
+	resource "twingate_resource" "%[1]s" {
+	  name              = "%[4]s"
+	  address           = "effective-access.example.com"
+	  remote_network_id = twingate_remote_network.%[1]s.id
+
+	  access_service {
+	    service_account_id = twingate_service_account.%[1]s.id
+	  }
+	}
+
+	data "twingate_effective_access" "by_service_account" {
+	  service_account_id = twingate_service_account.%[1]s.id
+	  depends_on         = [twingate_resource.%[1]s]
+	}
+
+	data "twingate_effective_access" "by_resource" {
+	  resource_id = twingate_resource.%[1]s.id
+	}
+	`, terraformName, networkName, serviceAccountName, resourceName)
+}
+
+func TestAccDatasourceTwingateEffectiveAccess(t *testing.T) {
+	t.Parallel()
+
+	terraformName := test.TerraformRandName("effective_access")
+	resourceName := test.RandomResourceName()
+	theResource := acctests.TerraformResource(terraformName)
+	theServiceAccount := acctests.TerraformServiceAccount(terraformName)
+	byServiceAccount := "data.twingate_effective_access.by_service_account"
+	byResource := "data.twingate_effective_access.by_resource"
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateResourceDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: effectiveAccessConfig(terraformName, test.RandomName(), test.RandomName(), resourceName),
+				Check: acctests.ComposeTestCheckFunc(
+					sdk.TestCheckResourceAttr(byServiceAccount, attr.Len(attr.Resources), "1"),
+					sdk.TestCheckResourceAttrPair(byServiceAccount, attr.Path(attr.Resources, attr.ID), theResource, attr.ID),
+					sdk.TestCheckResourceAttr(byServiceAccount, attr.Path(attr.Resources, attr.Name), resourceName),
+					sdk.TestCheckNoResourceAttr(byServiceAccount, attr.Path(attr.Resources, attr.GroupID)),
+					sdk.TestCheckResourceAttr(byResource, attr.Len(attr.ServiceAccountIDs), "1"),
+					sdk.TestCheckResourceAttrPair(byResource, attr.First(attr.ServiceAccountIDs), theServiceAccount, attr.ID),
+					sdk.TestCheckResourceAttr(byResource, attr.Len(attr.Users), "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatasourceTwingateEffectiveAccess_RequiresOneInput(t *testing.T) {
+	t.Parallel()
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		Steps: []sdk.TestStep{
+			{
+				Config: `
+				data "twingate_effective_access" "invalid" {
+				  user_id     = "user-id"
+				  resource_id = "resource-id"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
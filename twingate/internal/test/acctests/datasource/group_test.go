@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/acctests"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -103,3 +104,54 @@ func TestAccDatasourceTwingateGroup_invalidGroupID(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDatasourceTwingateGroup_reverseLookup(t *testing.T) {
+	t.Parallel()
+
+	terraformName := test.TerraformRandName("group_lookup")
+	theDatasource := "data.twingate_group." + terraformName
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testDatasourceTwingateGroupReverseLookup(terraformName, test.RandomName(), test.RandomName(), test.RandomResourceName()),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(theDatasource, attr.Len(attr.UserIDs), "0"),
+					resource.TestCheckResourceAttr(theDatasource, attr.Len(attr.ResourceIDs), "1"),
+					resource.TestCheckResourceAttrPair(theDatasource, attr.First(attr.ResourceIDs), acctests.TerraformResource(terraformName), attr.ID),
+				),
+			},
+		},
+	})
+}
+
+func testDatasourceTwingateGroupReverseLookup(terraformName, groupName, networkName, resourceName string) string {
+	return fmt.Sprintf(`
+	resource "twingate_group" "%[1]s" {
+	  name = "%[2]s"
+	}
+
+	resource "twingate_remote_network" "%[1]s" {
+	  name = "%[3]s"
+	}
+
+	resource "twingate_resource" "%[1]s" {
+	  name              = "%[4]s"
+	  address           = "group-lookup.example.com"
+	  remote_network_id = twingate_remote_network.%[1]s.id
+
+	  access_group {
+	    group_id = twingate_group.%[1]s.id
+	  }
+	}
+
+	data "twingate_group" "%[1]s" {
+	  id             = twingate_group.%[1]s.id
+	  reverse_lookup = true
+	  depends_on     = [twingate_resource.%[1]s]
+	}
+	`, terraformName, groupName, networkName, resourceName)
+}
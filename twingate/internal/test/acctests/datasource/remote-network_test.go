@@ -162,3 +162,52 @@ func testTwingateRemoteNetworkValidationFailed(id, name string) string {
 	}
 	`, id, name)
 }
+
+func TestAccDatasourceTwingateRemoteNetwork_reverseLookup(t *testing.T) {
+	t.Parallel()
+
+	terraformName := test.TerraformRandName("network_lookup")
+	theDatasource := "data.twingate_remote_network." + terraformName
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateRemoteNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testDatasourceTwingateRemoteNetworkReverseLookup(terraformName, test.RandomName(), test.RandomResourceName()),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(theDatasource, attr.Len(attr.ConnectorIDs), "1"),
+					resource.TestCheckResourceAttrPair(theDatasource, attr.First(attr.ConnectorIDs), acctests.TerraformConnector(terraformName), attr.ID),
+					resource.TestCheckResourceAttr(theDatasource, attr.Len(attr.ResourceIDs), "1"),
+					resource.TestCheckResourceAttrPair(theDatasource, attr.First(attr.ResourceIDs), acctests.TerraformResource(terraformName), attr.ID),
+					resource.TestCheckResourceAttr(theDatasource, attr.Len(attr.GatewayIDs), "0"),
+				),
+			},
+		},
+	})
+}
+
+func testDatasourceTwingateRemoteNetworkReverseLookup(terraformName, networkName, resourceName string) string {
+	return fmt.Sprintf(`
+	resource "twingate_remote_network" "%[1]s" {
+	  name = "%[2]s"
+	}
+
+	resource "twingate_connector" "%[1]s" {
+	  remote_network_id = twingate_remote_network.%[1]s.id
+	}
+
+	resource "twingate_resource" "%[1]s" {
+	  name              = "%[3]s"
+	  address           = "network-lookup.example.com"
+	  remote_network_id = twingate_remote_network.%[1]s.id
+	}
+
+	data "twingate_remote_network" "%[1]s" {
+	  id             = twingate_remote_network.%[1]s.id
+	  reverse_lookup = true
+	  depends_on     = [twingate_connector.%[1]s, twingate_resource.%[1]s]
+	}
+	`, terraformName, networkName, resourceName)
+}
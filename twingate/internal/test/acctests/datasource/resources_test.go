@@ -369,3 +369,117 @@ func testDatasourceTwingateResourcesTagsFilter(resourceName, networkName, name1,
 	}
 	`, resourceName, networkName, name1, name2, tag)
 }
+
+func TestAccDatasourceTwingateResourcesFilterBlock(t *testing.T) {
+	t.Parallel()
+
+	prefix := test.Prefix()
+	resourceName := test.RandomResourceName()
+	networkName := test.RandomName()
+	theDatasource := "data.twingate_resources." + resourceName
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testDatasourceTwingateResourcesFilterBlock(resourceName, networkName, prefix+"_app", prefix+"_legacy_app", prefix),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(theDatasource, resourcesLen, "1"),
+					resource.TestCheckResourceAttr(theDatasource, resourceNamePath, prefix+"_app"),
+				),
+			},
+		},
+	})
+}
+
+func testDatasourceTwingateResourcesFilterBlock(resourceName, networkName, name1, name2, prefix string) string {
+	return fmt.Sprintf(`
+	resource "twingate_remote_network" "%[2]s" {
+	  name = "%[2]s"
+	}
+
+	resource "twingate_resource" "%[1]s_1" {
+	  name = "%[3]s"
+	  address = "acc-test.com"
+	  remote_network_id = twingate_remote_network.%[2]s.id
+	}
+
+	resource "twingate_resource" "%[1]s_2" {
+	  name = "%[4]s"
+	  address = "acc-test.com"
+	  remote_network_id = twingate_remote_network.%[2]s.id
+	}
+
+	data "twingate_resources" "%[1]s" {
+	  filter {
+	    condition {
+	      field    = "name"
+	      operator = "prefix"
+	      value    = "%[5]s"
+	    }
+
+	    condition {
+	      field    = "name"
+	      operator = "contains"
+	      value    = "legacy"
+	      negate   = true
+	    }
+	  }
+
+	  depends_on = [twingate_resource.%[1]s_1, twingate_resource.%[1]s_2]
+	}
+	`, resourceName, networkName, name1, name2, prefix)
+}
+
+func TestAccDatasourceTwingateResourcesFilterByAddress(t *testing.T) {
+	t.Parallel()
+
+	resourceName := test.RandomResourceName()
+	networkName := test.RandomName()
+	theDatasource := "data.twingate_resources." + resourceName
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testDatasourceTwingateResourcesFilterByAddress(resourceName, networkName),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(theDatasource, resourcesLen, "1"),
+					resource.TestCheckResourceAttr(theDatasource, attr.Path(attr.Resources, attr.Address), "10.20.8.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func testDatasourceTwingateResourcesFilterByAddress(resourceName, networkName string) string {
+	return fmt.Sprintf(`
+	resource "twingate_remote_network" "%[2]s" {
+	  name = "%[2]s"
+	}
+
+	resource "twingate_resource" "%[1]s_1" {
+	  name = "%[1]s_1"
+	  address = "10.20.8.0/24"
+	  remote_network_id = twingate_remote_network.%[2]s.id
+	}
+
+	resource "twingate_resource" "%[1]s_2" {
+	  name = "%[1]s_2"
+	  address = "10.30.8.0/24"
+	  remote_network_id = twingate_remote_network.%[2]s.id
+	}
+
+	data "twingate_resources" "%[1]s" {
+	  name_prefix  = "%[1]s"
+	  address      = "10.20.0.0/16"
+	  address_mode = "cidr_contains"
+
+	  depends_on = [twingate_resource.%[1]s_1, twingate_resource.%[1]s_2]
+	}
+	`, resourceName, networkName)
+}
@@ -0,0 +1,62 @@
+package datasource
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/acctests"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatasourceTwingateServiceAccountKeys(t *testing.T) {
+	t.Parallel()
+
+	terraformResourceName := test.TerraformRandName("dts_keys")
+	serviceName := test.RandomName()
+	theDatasource := "data.twingate_service_account_keys.out"
+	expiring := "data.twingate_service_account_keys.expiring"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateServiceAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: datasourceServiceAccountKeys(terraformResourceName, serviceName),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(theDatasource, attr.Len(attr.Keys), "1"),
+					resource.TestCheckResourceAttr(theDatasource, attr.Path(attr.Keys, attr.Status), "ACTIVE"),
+					resource.TestCheckResourceAttr(theDatasource, attr.Path(attr.Keys, attr.DaysRemaining), "10"),
+					resource.TestCheckResourceAttrSet(theDatasource, attr.Path(attr.Keys, attr.ExpiresAt)),
+					resource.TestCheckResourceAttr(expiring, attr.Len(attr.Keys), "0"),
+				),
+			},
+		},
+	})
+}
+
+func datasourceServiceAccountKeys(terraformResourceName, serviceName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "twingate_service_account_key" "%[2]s" {
+	  service_account_id = twingate_service_account.%[2]s.id
+	  expiration_time    = 10
+	}
+
+	data "twingate_service_account_keys" "out" {
+	  service_account_id = twingate_service_account.%[2]s.id
+
+	  depends_on = [twingate_service_account_key.%[2]s]
+	}
+
+	data "twingate_service_account_keys" "expiring" {
+	  service_account_id = twingate_service_account.%[2]s.id
+	  expiring_within    = 5
+
+	  depends_on = [twingate_service_account_key.%[2]s]
+	}
+	`, createServiceAccount(terraformResourceName, serviceName), terraformResourceName)
+}
@@ -702,3 +702,48 @@ func terraformDatasourceUsersByEmailAndFirstNameLastNamePrefixAndRole(prefix, re
 	}
 `, prefix, role, resourceName)
 }
+
+func TestAccDatasourceTwingateUsers_filterByGroup(t *testing.T) {
+	t.Parallel()
+
+	resourceName := test.RandomName()
+	groupName := test.RandomGroupName()
+	datasourceName := test.RandomName()
+	email := test.TerraformRandName("group") + "_" + test.RandomEmail()
+	theDatasource := acctests.TerraformDatasourceUsers(datasourceName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: join(
+					terraformResourceTwingateUser(resourceName, email),
+					terraformDatasourceUsersByGroup(datasourceName, groupName, resourceName),
+				),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(theDatasource, attr.Len(attr.Users), "1"),
+					resource.TestCheckResourceAttr(theDatasource, attr.Path(attr.Users, attr.Email), email),
+					resource.TestCheckResourceAttr(theDatasource, attr.Path(attr.Users, attr.Type), "MANUAL"),
+					resource.TestCheckResourceAttrSet(theDatasource, attr.Path(attr.Users, attr.State)),
+					resource.TestCheckResourceAttr(theDatasource, attr.Len(attr.Path(attr.Users, attr.GroupIDs)), "1"),
+				),
+			},
+		},
+	})
+}
+
+func terraformDatasourceUsersByGroup(datasourceName, groupName, resourceName string) string {
+	return fmt.Sprintf(`
+	resource "twingate_group" "%[2]s" {
+	  name = "%[2]s"
+	  user_ids = [%[3]s.id]
+	}
+
+	data "twingate_users" "%[1]s" {
+	  group_id       = twingate_group.%[2]s.id
+	  type           = "MANUAL"
+	  reverse_lookup = true
+	}
+`, datasourceName, groupName, acctests.TerraformUser(resourceName))
+}
@@ -62,6 +62,58 @@ func TestAccDatasourceTwingateX509CertificateAuthority_basic(t *testing.T) {
 	})
 }
 
+func terraformDatasourceX509CertificateAuthorityWithCertificate(terraformResourceName, name, cert, dsCert string) string {
+	return fmt.Sprintf(`
+	resource "twingate_x509_certificate_authority" "%[1]s" {
+	  name        = "%[2]s"
+	  certificate = <<-EOF
+%[3]s
+	EOF
+	}
+
+	data "twingate_x509_certificate_authority" "%[1]s" {
+	  id          = twingate_x509_certificate_authority.%[1]s.id
+	  certificate = <<-EOF
+%[4]s
+	EOF
+	}
+	`, terraformResourceName, name, strings.TrimSpace(cert), strings.TrimSpace(dsCert))
+}
+
+func TestAccDatasourceTwingateX509CertificateAuthority_metadata(t *testing.T) {
+	t.Parallel()
+
+	terraformResourceName := test.TerraformRandName("test_x509_ds")
+	theResource := acctests.TerraformX509CertificateAuthority(terraformResourceName)
+	theDatasource := acctests.DatasourceName(datasource.TwingateX509CertificateAuthority, terraformResourceName)
+	name := test.RandomName()
+	cert := acctests.GenerateCACertPEM(t)
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		TerraformVersionChecks:   acctests.VersionCheckForWriteOnlyAttributes(),
+		CheckDestroy:             acctests.CheckTwingateX509CertificateAuthorityDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: terraformDatasourceX509CertificateAuthorityWithCertificate(terraformResourceName, name, cert, cert),
+				Check: acctests.ComposeTestCheckFunc(
+					sdk.TestCheckResourceAttrPair(theDatasource, attr.Subject, theResource, attr.Subject),
+					sdk.TestCheckResourceAttrPair(theDatasource, attr.Issuer, theResource, attr.Issuer),
+					sdk.TestCheckResourceAttrPair(theDatasource, attr.NotBefore, theResource, attr.NotBefore),
+					sdk.TestCheckResourceAttrPair(theDatasource, attr.NotAfter, theResource, attr.NotAfter),
+					sdk.TestCheckResourceAttrPair(theDatasource, attr.Serial, theResource, attr.Serial),
+					sdk.TestCheckResourceAttrPair(theDatasource, attr.KeyAlgorithm, theResource, attr.KeyAlgorithm),
+				),
+			},
+			{
+				Config:      terraformDatasourceX509CertificateAuthorityWithCertificate(terraformResourceName, name, cert, acctests.GenerateCACertPEM(t)),
+				ExpectError: regexp.MustCompile("Certificate mismatch"),
+			},
+		},
+	})
+}
+
 func testDatasourceTwingateX509CertificateAuthorityDoesNotExist(id string) string {
 	return fmt.Sprintf(`
 	data "twingate_x509_certificate_authority" "test" {
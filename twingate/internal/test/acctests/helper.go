@@ -438,6 +438,25 @@ func CheckTwingateResourceActiveState(resourceName string, expectedActiveState b
 	})
 }
 
+func CheckTwingateResourceActiveStateByID(resourceID **string, expectedActiveState bool) sdk.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if *resourceID == nil || **resourceID == "" {
+			return ErrResourceIDNotSet
+		}
+
+		res, err := providerClient.ReadResource(context.Background(), **resourceID)
+		if err != nil {
+			return fmt.Errorf("failed to read resource: %w", err)
+		}
+
+		if res.IsActive != expectedActiveState {
+			return fmt.Errorf("expected active state %v, got %v", expectedActiveState, res.IsActive) //nolint:err113
+		}
+
+		return nil
+	}
+}
+
 type checkResourceActiveState struct {
 	resourceAddress     string
 	expectedActiveState bool
@@ -1024,6 +1043,23 @@ func CheckTwingateConnectorTokensInvalidated(s *terraform.State) error {
 	return nil
 }
 
+// InvalidateConnectorTokens generates new tokens for the connector of the given twingate_connector_tokens
+// resource, invalidating the tokens kept in state.
+func InvalidateConnectorTokens(resourceName string) sdk.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connectorID, err := getResourceID(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if _, err := providerClient.GenerateConnectorTokens(context.Background(), connectorID); err != nil {
+			return fmt.Errorf("failed to invalidate tokens of connector with ID %s: %w", connectorID, err)
+		}
+
+		return nil
+	}
+}
+
 func GetTestUser() (*model.User, error) {
 	if providerClient == nil {
 		return nil, ErrClientNotInitialized
@@ -1137,6 +1173,15 @@ func CheckTwingateGatewayDestroy(s *terraform.State) error {
 func GenerateCACertPEM(t *testing.T) string {
 	t.Helper()
 
+	certPEM, _ := GenerateCACertAndKeyPEM(t)
+
+	return certPEM
+}
+
+// GenerateCACertAndKeyPEM returns a self-signed CA certificate and its PKCS#8 private key.
+func GenerateCACertAndKeyPEM(t *testing.T) (string, string) {
+	t.Helper()
+
 	const (
 		keySize    = 2048
 		hoursInDay = 24
@@ -1164,12 +1209,21 @@ func GenerateCACertPEM(t *testing.T) string {
 		t.Fatalf("failed to create certificate: %v", err)
 	}
 
-	var buf bytes.Buffer
-	if err := pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: certDER}); err != nil {
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal private key: %v", err)
+	}
+
+	var certBuf, keyBuf bytes.Buffer
+	if err := pem.Encode(&certBuf, &pem.Block{Type: "CERTIFICATE", Bytes: certDER}); err != nil {
 		t.Fatalf("failed to PEM-encode certificate: %v", err)
 	}
 
-	return buf.String()
+	if err := pem.Encode(&keyBuf, &pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}); err != nil {
+		t.Fatalf("failed to PEM-encode private key: %v", err)
+	}
+
+	return certBuf.String(), keyBuf.String()
 }
 
 func GenerateSSHPublicKey(t *testing.T) string {
@@ -1226,3 +1280,22 @@ func CheckSshResourceAlias(resourceName string, expectedAlias *string) sdk.TestC
 		return nil
 	}
 }
+
+func AddRemoteNetworkConnector(remoteNetworkName, connectorName string) sdk.TestCheckFunc {
+	return func(state *terraform.State) error {
+		remoteNetworkID, err := getResourceID(state, remoteNetworkName)
+		if err != nil {
+			return err
+		}
+
+		_, err = providerClient.CreateConnector(context.Background(), &model.Connector{
+			Name:      connectorName,
+			NetworkID: remoteNetworkID,
+		})
+		if err != nil {
+			return fmt.Errorf("remote network with ID %s failed to add connector %s: %w", remoteNetworkID, connectorName, err)
+		}
+
+		return nil
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/acctests"
 	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
@@ -36,6 +37,42 @@ func TestAccRemoteConnectorWithTokens(t *testing.T) {
 	})
 }
 
+func TestAccRemoteConnectorTokensReplacedWhenInvalidated(t *testing.T) {
+	t.Parallel()
+
+	const terraformResourceName = "test_t4"
+	theResource := acctests.TerraformConnectorTokens(terraformResourceName)
+	remoteNetworkName := test.RandomName()
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateConnectorTokensInvalidated,
+		Steps: []sdk.TestStep{
+			{
+				Config: terraformResourceTwingateConnectorTokens(terraformResourceName, remoteNetworkName),
+				Check: acctests.ComposeTestCheckFunc(
+					checkTwingateConnectorTokensSet(theResource),
+					sdk.TestCheckResourceAttr(theResource, attr.VerifyOnRead, "true"),
+					acctests.InvalidateConnectorTokens(theResource),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: terraformResourceTwingateConnectorTokens(terraformResourceName, remoteNetworkName),
+				ConfigPlanChecks: sdk.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(theResource, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: acctests.ComposeTestCheckFunc(
+					checkTwingateConnectorTokensSet(theResource),
+				),
+			},
+		},
+	})
+}
+
 func terraformResourceTwingateConnectorTokens(terraformResourceName, remoteNetworkName string) string {
 	return fmt.Sprintf(`
 	%s
@@ -114,3 +151,98 @@ func terraformResourceTwingateEphemeralConnectorTokens(terraformResourceName, re
 
 	`, terraformResourceTwingateConnector(terraformResourceName, terraformResourceName, remoteNetworkName), terraformResourceName, terraformResourceName)
 }
+
+func TestAccRemoteEphemeralConnectorDeployment(t *testing.T) {
+	t.Parallel()
+
+	const terraformResourceName = "test_t3"
+	remoteNetworkName := test.RandomName()
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck: func() {
+			acctests.PreCheck(t)
+
+			// Skip if running with OpenTofu
+			if strings.Contains(os.Getenv("TF_ACC_PROVIDER_HOST"), "opentofu.org") {
+				t.Skip("Ephemeral resources not supported in OpenTofu")
+			}
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Ephemeral resources require Terraform 1.10+
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		CheckDestroy: acctests.CheckTwingateConnectorTokensInvalidated,
+		Steps: []sdk.TestStep{
+			{
+				Config: terraformResourceTwingateEphemeralConnectorDeployment(terraformResourceName, remoteNetworkName),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckTwingateResourceExists(acctests.TerraformConnector(terraformResourceName)),
+				),
+			},
+		},
+	})
+}
+
+func terraformResourceTwingateEphemeralConnectorDeployment(terraformResourceName, remoteNetworkName string) string {
+	return fmt.Sprintf(`
+	%s
+	resource "twingate_connector_tokens" "%s" {
+	  connector_id = twingate_connector.%s.id
+	}
+
+	ephemeral "twingate_connector_deployment" "%s" {
+	  connector_id  = twingate_connector.%s.id
+	  format        = "kubernetes"
+	  namespace     = "twingate"
+	  access_token  = twingate_connector_tokens.%s.access_token
+	  refresh_token = twingate_connector_tokens.%s.refresh_token
+	}
+
+	`, terraformResourceTwingateConnector(terraformResourceName, terraformResourceName, remoteNetworkName), terraformResourceName, terraformResourceName,
+		terraformResourceName, terraformResourceName, terraformResourceName, terraformResourceName)
+}
+
+func TestAccRemoteEphemeralConnectorTokensVerification(t *testing.T) {
+	t.Parallel()
+
+	const terraformResourceName = "test_t5"
+	remoteNetworkName := test.RandomName()
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck: func() {
+			acctests.PreCheck(t)
+
+			// Skip if running with OpenTofu
+			if strings.Contains(os.Getenv("TF_ACC_PROVIDER_HOST"), "opentofu.org") {
+				t.Skip("Ephemeral resources not supported in OpenTofu")
+			}
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Ephemeral resources require Terraform 1.10+
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		CheckDestroy: acctests.CheckTwingateConnectorTokensInvalidated,
+		Steps: []sdk.TestStep{
+			{
+				Config: terraformResourceTwingateEphemeralConnectorTokensVerification(terraformResourceName, remoteNetworkName),
+				Check: acctests.ComposeTestCheckFunc(
+					checkTwingateConnectorTokensSet(acctests.TerraformConnectorTokens(terraformResourceName)),
+				),
+			},
+		},
+	})
+}
+
+func terraformResourceTwingateEphemeralConnectorTokensVerification(terraformResourceName, remoteNetworkName string) string {
+	return fmt.Sprintf(`
+	%s
+	ephemeral "twingate_connector_tokens_verification" "%s" {
+	  access_token  = twingate_connector_tokens.%s.access_token
+	  refresh_token = twingate_connector_tokens.%s.refresh_token
+	  require_valid = true
+	}
+
+	`, terraformResourceTwingateConnectorTokens(terraformResourceName, remoteNetworkName), terraformResourceName, terraformResourceName, terraformResourceName)
+}
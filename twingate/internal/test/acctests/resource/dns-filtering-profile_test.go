@@ -2,10 +2,12 @@ package resource
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/acctests"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -22,6 +24,7 @@ func TestAccTwingateDNSFilteringProfileCreateWithDefaultValues(t *testing.T) {
 	testName := "t" + acctest.RandString(6)
 	theResource := acctests.TerraformDNSFilteringProfile(testName)
 	profileName := test.RandomName(testName)
+	priority := test.RandomPriority()
 
 	sdk.Test(t, sdk.TestCase{
 		ProtoV6ProviderFactories: acctests.ProviderFactories,
@@ -29,10 +32,10 @@ func TestAccTwingateDNSFilteringProfileCreateWithDefaultValues(t *testing.T) {
 		CheckDestroy:             acctests.CheckTwingateDNSProfileDestroy,
 		Steps: []sdk.TestStep{
 			{
-				Config: testTwingateDNSFilteringProfileBase(testName, profileName, "2"),
+				Config: testTwingateDNSFilteringProfileBase(testName, profileName, priority),
 				Check: acctests.ComposeTestCheckFunc(
 					sdk.TestCheckResourceAttr(theResource, attr.Name, profileName),
-					sdk.TestCheckResourceAttr(theResource, attr.Priority, "2"),
+					sdk.TestCheckResourceAttr(theResource, attr.Priority, priority),
 					sdk.TestCheckResourceAttr(theResource, attr.FallbackMethod, "STRICT"),
 					sdk.TestCheckResourceAttr(theResource, groupsLen, "0"),
 				),
@@ -75,6 +78,7 @@ func TestAccTwingateDNSFilteringProfileCreate(t *testing.T) {
 	testName := "t" + acctest.RandString(6)
 	theResource := acctests.TerraformDNSFilteringProfile(testName)
 	profileName := test.RandomName(testName)
+	priority := test.RandomPriority()
 
 	groups, groupResources := genNewGroupsWithName(testName, testName, 3)
 	groupsTF := strings.Join(groups, "\n")
@@ -89,10 +93,10 @@ func TestAccTwingateDNSFilteringProfileCreate(t *testing.T) {
 		CheckDestroy:             acctests.CheckTwingateDNSProfileDestroy,
 		Steps: []sdk.TestStep{
 			{
-				Config: testTwingateDNSFilteringProfile(groupsTF, testName, profileName, groupResourcesTF, allowedDomains, deniedDomains),
+				Config: testTwingateDNSFilteringProfile(groupsTF, testName, profileName, groupResourcesTF, priority, allowedDomains, deniedDomains),
 				Check: acctests.ComposeTestCheckFunc(
 					sdk.TestCheckResourceAttr(theResource, attr.Name, profileName),
-					sdk.TestCheckResourceAttr(theResource, attr.Priority, "2"),
+					sdk.TestCheckResourceAttr(theResource, attr.Priority, priority),
 					sdk.TestCheckResourceAttr(theResource, attr.FallbackMethod, "AUTO"),
 					sdk.TestCheckResourceAttr(theResource, groupsLen, "3"),
 					sdk.TestCheckResourceAttr(theResource, attr.PathAttr(attr.AllowedDomains, attr.IsAuthoritative), "false"),
@@ -109,14 +113,14 @@ func TestAccTwingateDNSFilteringProfileCreate(t *testing.T) {
 	})
 }
 
-func testTwingateDNSFilteringProfile(groups, testName, profileName, groupResources string, allowedDomains, deniedDomains []string) string {
+func testTwingateDNSFilteringProfile(groups, testName, profileName, groupResources, priority string, allowedDomains, deniedDomains []string) string {
 	return fmt.Sprintf(`
 	# groups
 	%[1]s
 
 	resource "twingate_dns_filtering_profile" "%[2]s" {
 	  name = "%[3]s"
-	  priority = 2
+	  priority = %[7]s
 	  fallback_method = "AUTO"
 	  groups = toset(data.twingate_groups.test.groups[*].id)
 	
@@ -151,7 +155,7 @@ func testTwingateDNSFilteringProfile(groups, testName, profileName, groupResourc
 	  depends_on = [%[6]s]
 	}
 
-	`, groups, testName, profileName, listToString(allowedDomains), listToString(deniedDomains), groupResources)
+	`, groups, testName, profileName, listToString(allowedDomains), listToString(deniedDomains), groupResources, priority)
 
 }
 
@@ -175,6 +179,8 @@ func TestAccTwingateDNSFilteringProfileUpdate(t *testing.T) {
 	testName := "t" + acctest.RandString(6)
 	theResource := acctests.TerraformDNSFilteringProfile(testName)
 	profileName := test.RandomName(testName)
+	priority1 := test.RandomPriority()
+	priority2 := priority1 + ".5"
 
 	groups1, groupResources1 := genNewGroupsWithName(testName, testName, 2)
 	groupsTF1 := strings.Join(groups1, "\n")
@@ -193,10 +199,10 @@ func TestAccTwingateDNSFilteringProfileUpdate(t *testing.T) {
 		CheckDestroy:             acctests.CheckTwingateDNSProfileDestroy,
 		Steps: []sdk.TestStep{
 			{
-				Config: testTwingateDNSFilteringProfile1(groupsTF1, testName, profileName, groupResourcesTF1, "3", "AUTO", true, domains1, true),
+				Config: testTwingateDNSFilteringProfile1(groupsTF1, testName, profileName, groupResourcesTF1, priority1, "AUTO", true, domains1, true),
 				Check: acctests.ComposeTestCheckFunc(
 					sdk.TestCheckResourceAttr(theResource, attr.Name, profileName),
-					sdk.TestCheckResourceAttr(theResource, attr.Priority, "3"),
+					sdk.TestCheckResourceAttr(theResource, attr.Priority, priority1),
 					sdk.TestCheckResourceAttr(theResource, attr.FallbackMethod, "AUTO"),
 					sdk.TestCheckResourceAttr(theResource, groupsLen, "2"),
 					sdk.TestCheckResourceAttr(theResource, attr.PathAttr(attr.AllowedDomains, attr.IsAuthoritative), "true"),
@@ -205,10 +211,10 @@ func TestAccTwingateDNSFilteringProfileUpdate(t *testing.T) {
 				),
 			},
 			{
-				Config: testTwingateDNSFilteringProfile1(groupsTF2, testName, profileName, groupResourcesTF2, "2.5", "STRICT", true, domains2, false),
+				Config: testTwingateDNSFilteringProfile1(groupsTF2, testName, profileName, groupResourcesTF2, priority2, "STRICT", true, domains2, false),
 				Check: acctests.ComposeTestCheckFunc(
 					sdk.TestCheckResourceAttr(theResource, attr.Name, profileName),
-					sdk.TestCheckResourceAttr(theResource, attr.Priority, "2.5"),
+					sdk.TestCheckResourceAttr(theResource, attr.Priority, priority2),
 					sdk.TestCheckResourceAttr(theResource, attr.FallbackMethod, "STRICT"),
 					sdk.TestCheckResourceAttr(theResource, groupsLen, "3"),
 					sdk.TestCheckResourceAttr(theResource, attr.PathAttr(attr.AllowedDomains, attr.IsAuthoritative), "true"),
@@ -258,6 +264,7 @@ func TestAccTwingateDNSFilteringProfileUpdateIsAuthoritativeTrue(t *testing.T) {
 	testName := "t" + acctest.RandString(6)
 	theResource := acctests.TerraformDNSFilteringProfile(testName)
 	profileName := test.RandomName(testName)
+	priority := test.RandomPriority()
 
 	domains1 := genDomains(2)
 	newDomains := genDomains(1)
@@ -269,7 +276,7 @@ func TestAccTwingateDNSFilteringProfileUpdateIsAuthoritativeTrue(t *testing.T) {
 		CheckDestroy:             acctests.CheckTwingateDNSProfileDestroy,
 		Steps: []sdk.TestStep{
 			{
-				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, true, domains1),
+				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, priority, true, domains1),
 				Check: acctests.ComposeTestCheckFunc(
 					sdk.TestCheckResourceAttr(theResource, attr.Name, profileName),
 					sdk.TestCheckResourceAttr(theResource, attr.LenAttr(attr.AllowedDomains, attr.Domains), "2"),
@@ -281,7 +288,7 @@ func TestAccTwingateDNSFilteringProfileUpdateIsAuthoritativeTrue(t *testing.T) {
 				ExpectNonEmptyPlan: true,
 			},
 			{
-				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, true, domains2),
+				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, priority, true, domains2),
 				Check: acctests.ComposeTestCheckFunc(
 					sdk.TestCheckResourceAttr(theResource, attr.Name, profileName),
 					sdk.TestCheckResourceAttr(theResource, attr.LenAttr(attr.AllowedDomains, attr.Domains), "4"),
@@ -291,18 +298,18 @@ func TestAccTwingateDNSFilteringProfileUpdateIsAuthoritativeTrue(t *testing.T) {
 	})
 }
 
-func testTwingateDNSFilteringProfileWithDomains(testName, profileName string, isAuthoritative bool, domains []string) string {
+func testTwingateDNSFilteringProfileWithDomains(testName, profileName, priority string, isAuthoritative bool, domains []string) string {
 	return fmt.Sprintf(`
 	resource "twingate_dns_filtering_profile" "%[1]s" {
 	  name = "%[2]s"
-	  priority = 5
+	  priority = %[5]s
 	
 	  allowed_domains {
 		is_authoritative = %[3]v
 		domains = ["%[4]s"]
 	  }
 	}
-	`, testName, profileName, isAuthoritative, strings.Join(domains, `", "`))
+	`, testName, profileName, isAuthoritative, strings.Join(domains, `", "`), priority)
 
 }
 
@@ -312,6 +319,7 @@ func TestAccTwingateDNSFilteringProfileUpdateIsAuthoritativeFalse(t *testing.T)
 	testName := "t" + acctest.RandString(6)
 	theResource := acctests.TerraformDNSFilteringProfile(testName)
 	profileName := test.RandomName(testName)
+	priority := test.RandomPriority()
 
 	domains1 := genDomains(2)
 	newDomains := genDomains(1)
@@ -323,7 +331,7 @@ func TestAccTwingateDNSFilteringProfileUpdateIsAuthoritativeFalse(t *testing.T)
 		CheckDestroy:             acctests.CheckTwingateDNSProfileDestroy,
 		Steps: []sdk.TestStep{
 			{
-				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, false, domains1),
+				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, priority, false, domains1),
 				Check: acctests.ComposeTestCheckFunc(
 					sdk.TestCheckResourceAttr(theResource, attr.Name, profileName),
 					sdk.TestCheckResourceAttr(theResource, attr.LenAttr(attr.AllowedDomains, attr.Domains), "2"),
@@ -333,7 +341,7 @@ func TestAccTwingateDNSFilteringProfileUpdateIsAuthoritativeFalse(t *testing.T)
 				),
 			},
 			{
-				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, false, domains2),
+				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, priority, false, domains2),
 				Check: acctests.ComposeTestCheckFunc(
 					sdk.TestCheckResourceAttr(theResource, attr.Name, profileName),
 					sdk.TestCheckResourceAttr(theResource, attr.LenAttr(attr.AllowedDomains, attr.Domains), "2"),
@@ -351,6 +359,7 @@ func TestAccTwingateDNSFilteringProfileUpdateIsAuthoritativeFalseTrue(t *testing
 	testName := "t" + acctest.RandString(6)
 	theResource := acctests.TerraformDNSFilteringProfile(testName)
 	profileName := test.RandomName(testName)
+	priority := test.RandomPriority()
 
 	domains1 := genDomains(2)
 	domains2 := genDomains(4)
@@ -361,14 +370,14 @@ func TestAccTwingateDNSFilteringProfileUpdateIsAuthoritativeFalseTrue(t *testing
 		CheckDestroy:             acctests.CheckTwingateDNSProfileDestroy,
 		Steps: []sdk.TestStep{
 			{
-				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, false, domains1),
+				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, priority, false, domains1),
 				Check: acctests.ComposeTestCheckFunc(
 					sdk.TestCheckResourceAttr(theResource, attr.Name, profileName),
 					sdk.TestCheckResourceAttr(theResource, attr.LenAttr(attr.AllowedDomains, attr.Domains), "2"),
 				),
 			},
 			{
-				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, true, domains2),
+				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, priority, true, domains2),
 				Check: acctests.ComposeTestCheckFunc(
 					sdk.TestCheckResourceAttr(theResource, attr.Name, profileName),
 					sdk.TestCheckResourceAttr(theResource, attr.LenAttr(attr.AllowedDomains, attr.Domains), "4"),
@@ -386,6 +395,7 @@ func TestAccTwingateDNSFilteringProfileUpdateIsAuthoritativeTrueFalse(t *testing
 	testName := "t" + acctest.RandString(6)
 	theResource := acctests.TerraformDNSFilteringProfile(testName)
 	profileName := test.RandomName(testName)
+	priority := test.RandomPriority()
 
 	domains1 := genDomains(2)
 	domains2 := genDomains(4)
@@ -397,14 +407,14 @@ func TestAccTwingateDNSFilteringProfileUpdateIsAuthoritativeTrueFalse(t *testing
 		CheckDestroy:             acctests.CheckTwingateDNSProfileDestroy,
 		Steps: []sdk.TestStep{
 			{
-				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, true, domains1),
+				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, priority, true, domains1),
 				Check: acctests.ComposeTestCheckFunc(
 					sdk.TestCheckResourceAttr(theResource, attr.Name, profileName),
 					sdk.TestCheckResourceAttr(theResource, attr.LenAttr(attr.AllowedDomains, attr.Domains), "2"),
 				),
 			},
 			{
-				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, false, domains2),
+				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, priority, false, domains2),
 				Check: acctests.ComposeTestCheckFunc(
 					sdk.TestCheckResourceAttr(theResource, attr.Name, profileName),
 					sdk.TestCheckResourceAttr(theResource, attr.LenAttr(attr.AllowedDomains, attr.Domains), "4"),
@@ -416,7 +426,7 @@ func TestAccTwingateDNSFilteringProfileUpdateIsAuthoritativeTrueFalse(t *testing
 				),
 			},
 			{
-				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, false, domains2),
+				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, priority, false, domains2),
 				Check: acctests.ComposeTestCheckFunc(
 					sdk.TestCheckResourceAttr(theResource, attr.Name, profileName),
 					sdk.TestCheckResourceAttr(theResource, attr.LenAttr(attr.AllowedDomains, attr.Domains), "4"),
@@ -434,6 +444,7 @@ func TestAccTwingateDNSFilteringProfileRemoveAllowedDomains(t *testing.T) {
 	testName := "t" + acctest.RandString(6)
 	theResource := acctests.TerraformDNSFilteringProfile(testName)
 	profileName := test.RandomName(testName)
+	priority := test.RandomPriority()
 
 	domains1 := genDomains(2)
 
@@ -443,14 +454,14 @@ func TestAccTwingateDNSFilteringProfileRemoveAllowedDomains(t *testing.T) {
 		CheckDestroy:             acctests.CheckTwingateDNSProfileDestroy,
 		Steps: []sdk.TestStep{
 			{
-				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, true, domains1),
+				Config: testTwingateDNSFilteringProfileWithDomains(testName, profileName, priority, true, domains1),
 				Check: acctests.ComposeTestCheckFunc(
 					sdk.TestCheckResourceAttr(theResource, attr.Name, profileName),
 					sdk.TestCheckResourceAttr(theResource, attr.LenAttr(attr.AllowedDomains, attr.Domains), "2"),
 				),
 			},
 			{
-				Config: testTwingateDNSFilteringProfileBase(testName, profileName, "5"),
+				Config: testTwingateDNSFilteringProfileBase(testName, profileName, priority),
 				Check: acctests.ComposeTestCheckFunc(
 					sdk.TestCheckResourceAttr(theResource, attr.Name, profileName),
 					sdk.TestCheckResourceAttr(theResource, attr.LenAttr(attr.AllowedDomains, attr.Domains), "0"),
@@ -468,6 +479,7 @@ func TestAccTwingateDNSFilteringProfileImport(t *testing.T) {
 	testName := "t" + acctest.RandString(6)
 	theResource := acctests.TerraformDNSFilteringProfile(testName)
 	profileName := test.RandomName(testName)
+	priority := test.RandomPriority()
 
 	groups, groupResources := genNewGroupsWithName(testName, testName, 3)
 	groupsTF := strings.Join(groups, "\n")
@@ -482,7 +494,7 @@ func TestAccTwingateDNSFilteringProfileImport(t *testing.T) {
 		CheckDestroy:             acctests.CheckTwingateResourceDestroy,
 		Steps: []sdk.TestStep{
 			{
-				Config: testTwingateDNSFilteringProfileFull(groupsTF, testName, profileName, groupResourcesTF, allowedDomains, deniedDomains),
+				Config: testTwingateDNSFilteringProfileFull(groupsTF, testName, profileName, groupResourcesTF, priority, allowedDomains, deniedDomains),
 				Check: acctests.ComposeTestCheckFunc(
 					acctests.CheckTwingateResourceExists(theResource),
 				),
@@ -492,7 +504,7 @@ func TestAccTwingateDNSFilteringProfileImport(t *testing.T) {
 				ResourceName: theResource,
 				ImportStateCheck: acctests.CheckImportState(map[string]string{
 					attr.Name:                 profileName,
-					attr.Priority:             "3",
+					attr.Priority:             priority,
 					attr.FallbackMethod:       "AUTO",
 					attr.LenAttr(attr.Groups): "3",
 
@@ -532,14 +544,14 @@ func TestAccTwingateDNSFilteringProfileImport(t *testing.T) {
 	})
 }
 
-func testTwingateDNSFilteringProfileFull(groups, testName, profileName, groupResources string, allowedDomains, deniedDomains []string) string {
+func testTwingateDNSFilteringProfileFull(groups, testName, profileName, groupResources, priority string, allowedDomains, deniedDomains []string) string {
 	return fmt.Sprintf(`
 	# groups
 	%[1]s
 
 	resource "twingate_dns_filtering_profile" "%[2]s" {
 	  name = "%[3]s"
-	  priority = 3
+	  priority = %[7]s
 	  fallback_method = "AUTO"
 	  groups = toset(data.twingate_groups.test.groups[*].id)
 	
@@ -574,6 +586,129 @@ func testTwingateDNSFilteringProfileFull(groups, testName, profileName, groupRes
 	  depends_on = [%[6]s]
 	}
 
-	`, groups, testName, profileName, listToString(allowedDomains), listToString(deniedDomains), groupResources)
+	`, groups, testName, profileName, listToString(allowedDomains), listToString(deniedDomains), groupResources, priority)
 
 }
+
+func TestAccTwingateDNSFilteringProfileDuplicatePriority(t *testing.T) {
+	t.Parallel()
+
+	testName := "t" + acctest.RandString(6)
+	theResource := acctests.TerraformDNSFilteringProfile(testName)
+	profileName := test.RandomName(testName)
+	priority := test.RandomPriority()
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateDNSProfileDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: testTwingateDNSFilteringProfileWithGroup(testName, profileName, priority, "", false),
+				Check: acctests.ComposeTestCheckFunc(
+					sdk.TestCheckResourceAttr(theResource, attr.Priority, priority),
+				),
+			},
+			{
+				Config:      testTwingateDNSFilteringProfileWithGroup(testName, profileName, priority, "", true),
+				ExpectError: regexp.MustCompile("(?s)Duplicate DNS filtering profile priority.*Ambiguous DNS filtering profile\\s+precedence"),
+			},
+			{
+				Config: testTwingateDNSFilteringProfileWithGroup(testName, profileName, priority, providerdata.ConflictsWarn, true),
+				Check: acctests.ComposeTestCheckFunc(
+					sdk.TestCheckResourceAttr(theResource+"_duplicate", attr.Priority, priority),
+				),
+			},
+		},
+	})
+}
+
+func testTwingateDNSFilteringProfileWithGroup(testName, profileName, priority, conflicts string, withDuplicate bool) string {
+	var provider, duplicate string
+
+	if conflicts != "" {
+		provider = fmt.Sprintf(`
+	provider "twingate" {
+	  dns_filtering_profile_priority_conflicts = "%s"
+	}
+	`, conflicts)
+	}
+
+	if withDuplicate {
+		duplicate = fmt.Sprintf(`
+	resource "twingate_dns_filtering_profile" "%[1]s_duplicate" {
+	  name = "%[2]s-duplicate"
+	  priority = %[3]s
+	  groups = [twingate_group.%[1]s.id]
+	}
+	`, testName, profileName, priority)
+	}
+
+	return fmt.Sprintf(`
+	resource "twingate_group" "%[1]s" {
+	  name = "%[2]s"
+	}
+
+	resource "twingate_dns_filtering_profile" "%[1]s" {
+	  name = "%[2]s"
+	  priority = %[3]s
+	  groups = [twingate_group.%[1]s.id]
+	}
+	%[4]s
+	%[5]s
+	`, testName, profileName, priority, duplicate, provider)
+}
+
+func TestAccTwingateDNSFilteringProfileDomainsFromSource(t *testing.T) {
+	t.Parallel()
+
+	testName := "t" + acctest.RandString(6)
+	theResource := acctests.TerraformDNSFilteringProfile(testName)
+	profileName := test.RandomName(testName)
+	priority := test.RandomPriority()
+
+	domains := genDomains(3)
+	hosts1 := fmt.Sprintf("# blocklist\\n0.0.0.0 %s %s\\n0.0.0.0 *.%s", domains[0], domains[1], strings.ToUpper(domains[1]))
+	hosts2 := fmt.Sprintf("0.0.0.0 %s\\n0.0.0.0 %s", domains[1], domains[2])
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateDNSProfileDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: testTwingateDNSFilteringProfileWithSource(testName, profileName, priority, hosts1),
+				Check: acctests.ComposeTestCheckFunc(
+					sdk.TestCheckResourceAttr(theResource, attr.LenAttr(attr.DeniedDomains, attr.Domains), "2"),
+					sdk.TestCheckResourceAttr(theResource, attr.PathAttr(attr.DeniedDomains, attr.SourceSummary), "2 domains, 2 added, 0 removed"),
+				),
+			},
+			{
+				Config: testTwingateDNSFilteringProfileWithSource(testName, profileName, priority, hosts2),
+				Check: acctests.ComposeTestCheckFunc(
+					sdk.TestCheckResourceAttr(theResource, attr.LenAttr(attr.DeniedDomains, attr.Domains), "2"),
+					sdk.TestCheckTypeSetElemAttr(theResource, attr.PathAttr(attr.DeniedDomains, attr.Domains)+".*", domains[2]),
+					sdk.TestCheckResourceAttr(theResource, attr.PathAttr(attr.DeniedDomains, attr.SourceSummary), "2 domains, 1 added, 1 removed"),
+				),
+			},
+			{
+				Config:      testTwingateDNSFilteringProfileWithSource(testName, profileName, priority, "0.0.0.0 -invalid-.com"),
+				ExpectError: regexp.MustCompile("Invalid Domain List"),
+			},
+		},
+	})
+}
+
+func testTwingateDNSFilteringProfileWithSource(testName, profileName, priority, source string) string {
+	return fmt.Sprintf(`
+	resource "twingate_dns_filtering_profile" "%[1]s" {
+	  name = "%[2]s"
+	  priority = %[3]s
+
+	  denied_domains {
+		source = "%[4]s"
+		source_format = "hosts"
+	  }
+	}
+	`, testName, profileName, priority, source)
+}
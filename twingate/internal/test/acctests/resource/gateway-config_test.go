@@ -691,3 +691,118 @@ func TestAccTwingateGatewayConfigUpdate_ContentChanges(t *testing.T) {
 		},
 	})
 }
+
+func gatewayConfigWithGatewayID(tfName, gatewayTFName string) string {
+	return fmt.Sprintf(`
+	resource "twingate_gateway_config" "%s" {
+	  gateway_id = twingate_gateway.%s.id
+	}
+	`, tfName, gatewayTFName)
+}
+
+func TestAccTwingateGatewayConfig_DiscoveredResources(t *testing.T) {
+	t.Parallel()
+
+	tfName := test.TerraformRandName("test_gw_cfg")
+	theResource := acctests.TerraformGatewayConfig(tfName)
+	remoteNetworkTFName := test.TerraformRandName("test_rn")
+	x509TFName := test.TerraformRandName("test_x509")
+	sshCATFName := test.TerraformRandName("test_ssh_ca")
+	gatewayTFName := test.TerraformRandName("test_gw")
+	sshResTFName := test.TerraformRandName("test_ssh_res")
+	sshResourceName := test.RandomName()
+
+	prereqs := sshResourcePrerequisites(test.RandomName(), remoteNetworkTFName, x509TFName, acctests.GenerateCACertPEM(t),
+		sshCATFName, acctests.GenerateSSHPublicKey(t), gatewayTFName, "10.0.0.9:8080") +
+		terraformResourceSSHResource(sshResTFName, gatewayTFName, remoteNetworkTFName, sshResourceName, "10.0.0.9")
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		TerraformVersionChecks:   acctests.VersionCheckForWriteOnlyAttributes(),
+		CheckDestroy:             acctests.CheckTwingateSSHResourceDestroy,
+		Steps: []sdk.TestStep{
+			{
+				// the SSH resource must exist before the config is planned
+				Config: prereqs,
+			},
+			{
+				Config: prereqs + gatewayConfigWithGatewayID(tfName, gatewayTFName),
+				Check: acctests.ComposeTestCheckFunc(
+					sdk.TestCheckResourceAttr(theResource, attr.DiscoveredResources+".#", "1"),
+					sdk.TestCheckResourceAttr(theResource, attr.DiscoveredResources+".0.type", "ssh"),
+					sdk.TestCheckResourceAttr(theResource, attr.DiscoveredResources+".0.name", sshResourceName),
+					checkYAMLContent(theResource, func(doc map[string]any) error {
+						ssh, ok := doc["ssh"].(map[string]any)
+						if !ok {
+							return fmt.Errorf("expected ssh block to be present")
+						}
+						upstreams, ok := ssh["upstreams"].([]any)
+						if !ok || len(upstreams) != 1 {
+							return fmt.Errorf("expected 1 ssh upstream, got %v", ssh["upstreams"])
+						}
+						if u := upstreams[0].(map[string]any); u["name"] != sshResourceName {
+							return fmt.Errorf("expected upstream name %q, got %v", sshResourceName, u["name"])
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func gatewayConfigWithDuplicateSSHNames(tfName, sshName string) string {
+	return fmt.Sprintf(`
+	resource "twingate_gateway_config" "%s" {
+	  ssh = {
+	    resources = [
+	      {
+	        name     = "%s"
+	        address  = "10.0.0.1"
+	        username = "ubuntu"
+	      },
+	      {
+	        name     = "%s"
+	        address  = "10.0.0.2"
+	        username = "ubuntu"
+	      }
+	    ]
+	  }
+	}
+	`, tfName, sshName, sshName)
+}
+
+func TestAccTwingateGatewayConfig_DuplicateUpstreamNames(t *testing.T) {
+	t.Parallel()
+
+	tfName := test.TerraformRandName("test_gw_cfg")
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		Steps: []sdk.TestStep{
+			{
+				Config:      gatewayConfigWithDuplicateSSHNames(tfName, "web"),
+				ExpectError: regexp.MustCompile(`More than one SSH upstream is named "web"`),
+			},
+		},
+	})
+}
+
+func TestAccTwingateGatewayConfig_PortConflict(t *testing.T) {
+	t.Parallel()
+
+	tfName := test.TerraformRandName("test_gw_cfg")
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		Steps: []sdk.TestStep{
+			{
+				Config:      gatewayConfigWithCustomPort(tfName, 9090, 9090, "web", "10.0.0.1", "ubuntu"),
+				ExpectError: regexp.MustCompile(`"port" and "metrics_port" can't both be 9090`),
+			},
+		},
+	})
+}
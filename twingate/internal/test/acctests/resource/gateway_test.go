@@ -3,6 +3,9 @@ package resource
 import (
 	"errors"
 	"fmt"
+	"os"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
@@ -11,6 +14,7 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/acctests"
 	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func terraformResourceGateway(terraformResourceName, remoteNetworkResourceName, address, x509ResourceName string) string {
@@ -361,3 +365,60 @@ func TestAccTwingateGatewayReCreateAfterDeletion(t *testing.T) {
 		},
 	})
 }
+
+func terraformEphemeralGatewayTLSBundle(terraformResourceName, gatewayTFName, caCertPEM, caKeyPEM string) string {
+	return fmt.Sprintf(`
+	ephemeral "twingate_gateway_tls_bundle" "%s" {
+	  gateway_id     = twingate_gateway.%s.id
+	  dns_names      = ["gateway.internal"]
+	  ca_certificate = <<-EOT
+%s
+	EOT
+	  ca_private_key = <<-EOT
+%s
+	EOT
+	}
+	`, terraformResourceName, gatewayTFName, strings.TrimSpace(caCertPEM), strings.TrimSpace(caKeyPEM))
+}
+
+func TestAccTwingateEphemeralGatewayTLSBundle(t *testing.T) {
+	t.Parallel()
+
+	remoteNetworkTFName := test.TerraformRandName("test_rn")
+	x509TFName := test.TerraformRandName("test_x509")
+	gatewayTFName := test.TerraformRandName("test_gw")
+	certPEM, keyPEM := acctests.GenerateCACertAndKeyPEM(t)
+	otherCertPEM, otherKeyPEM := acctests.GenerateCACertAndKeyPEM(t)
+
+	config := gatewayPrerequisites(test.RandomName(), remoteNetworkTFName, x509TFName, certPEM) +
+		terraformResourceGateway(gatewayTFName, remoteNetworkTFName, "10.0.0.1:8443", x509TFName)
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck: func() {
+			acctests.PreCheck(t)
+
+			// Skip if running with OpenTofu
+			if strings.Contains(os.Getenv("TF_ACC_PROVIDER_HOST"), "opentofu.org") {
+				t.Skip("Ephemeral resources not supported in OpenTofu")
+			}
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Write-only attributes require Terraform 1.11+
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: acctests.CheckTwingateGatewayDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: config + terraformEphemeralGatewayTLSBundle("bundle", gatewayTFName, certPEM, keyPEM),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckTwingateResourceExists(acctests.TerraformGateway(gatewayTFName)),
+				),
+			},
+			{
+				Config:      config + terraformEphemeralGatewayTLSBundle("bundle", gatewayTFName, otherCertPEM, otherKeyPEM),
+				ExpectError: regexp.MustCompile("doesn't match the Gateway's X509 Certificate Authority"),
+			},
+		},
+	})
+}
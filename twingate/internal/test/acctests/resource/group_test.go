@@ -373,3 +373,33 @@ func terraformResourceTwingateGroupFull(terraformResourceName, name string, user
 	}
 	`, strings.Join(users, "\n"), terraformResourceName, name, strings.Join(usersID, ", "), authoritative)
 }
+
+func TestAccTwingateGroupImportByName(t *testing.T) {
+	t.Parallel()
+
+	const terraformResourceName = "test009"
+	theResource := acctests.TerraformGroup(terraformResourceName)
+	groupName := test.RandomGroupName()
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateGroupDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: terraformResourceTwingateGroup(terraformResourceName, groupName),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckTwingateResourceExists(theResource),
+				),
+			},
+			{
+				ImportState:   true,
+				ImportStateId: "name:" + groupName,
+				ResourceName:  theResource,
+				ImportStateCheck: acctests.CheckImportState(map[string]string{
+					attr.Name: groupName,
+				}),
+			},
+		},
+	})
+}
@@ -2,6 +2,8 @@ package resource
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
@@ -80,12 +82,23 @@ func TestAccTwingateRemoteNetworkUpdate(t *testing.T) {
 	})
 }
 
-func terraformResourceRemoteNetwork(terraformResourceName, name string) string {
+// terraformResourceRemoteNetwork returns a Remote Network config, with any further attributes given as HCL lines.
+func terraformResourceRemoteNetwork(terraformResourceName, name string, attributes ...string) string {
 	return fmt.Sprintf(`
 	resource "twingate_remote_network" "%s" {
 	  name = "%s"
+	  %s
 	}
-	`, terraformResourceName, name)
+	`, terraformResourceName, name, strings.Join(attributes, "\n\t  "))
+}
+
+func remoteNetworkTimeouts(timeout string) string {
+	return fmt.Sprintf(`timeouts {
+	    create = "%[1]s"
+	    read   = "%[1]s"
+	    update = "%[1]s"
+	    delete = "%[1]s"
+	  }`, timeout)
 }
 
 func TestAccTwingateRemoteNetworkDeleteNonExisting(t *testing.T) {
@@ -249,3 +262,104 @@ func terraformResourceRemoteNetworkExitNode(terraformResourceName, name, network
 	}
 	`, terraformResourceName, name, networkType)
 }
+
+func TestAccTwingateRemoteNetworkDeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	const terraformResourceName = "test008"
+	theResource := acctests.TerraformRemoteNetwork(terraformResourceName)
+	networkName := test.RandomName()
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateRemoteNetworkDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: terraformResourceRemoteNetwork(terraformResourceName, networkName, "deletion_protection = true"),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckTwingateResourceExists(theResource),
+					sdk.TestCheckResourceAttr(theResource, attr.DeletionProtection, "true"),
+				),
+			},
+			{
+				Config:      terraformResourceRemoteNetwork(terraformResourceName, networkName, "deletion_protection = true"),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`deletion_protection is enabled`),
+			},
+			{
+				Config: terraformResourceRemoteNetwork(terraformResourceName, networkName, "deletion_protection = false"),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckTwingateResourceExists(theResource),
+					sdk.TestCheckResourceAttr(theResource, attr.DeletionProtection, "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTwingateRemoteNetworkForceDestroy(t *testing.T) {
+	t.Parallel()
+
+	const terraformResourceName = "test009"
+	theResource := acctests.TerraformRemoteNetwork(terraformResourceName)
+	networkName := test.RandomName()
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateRemoteNetworkDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: terraformResourceRemoteNetwork(terraformResourceName, networkName, "force_destroy = false"),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckTwingateResourceExists(theResource),
+					sdk.TestCheckResourceAttr(theResource, attr.ForceDestroy, "false"),
+					acctests.AddRemoteNetworkConnector(theResource, test.RandomConnectorName()),
+				),
+			},
+			{
+				Config:      terraformResourceRemoteNetwork(terraformResourceName, networkName, "force_destroy = false"),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`still has attached objects`),
+			},
+			{
+				Config: terraformResourceRemoteNetwork(terraformResourceName, networkName, "force_destroy = true"),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckTwingateResourceExists(theResource),
+					sdk.TestCheckResourceAttr(theResource, attr.ForceDestroy, "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTwingateRemoteNetworkWithTimeouts(t *testing.T) {
+	t.Parallel()
+
+	const terraformResourceName = "test010"
+	theResource := acctests.TerraformRemoteNetwork(terraformResourceName)
+	networkName := test.RandomName()
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateRemoteNetworkDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: terraformResourceRemoteNetwork(terraformResourceName, networkName, remoteNetworkTimeouts("5m")),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckTwingateResourceExists(theResource),
+					sdk.TestCheckResourceAttr(theResource, attr.Path(attr.Timeouts, "create"), "5m"),
+				),
+			},
+			{
+				Config: terraformResourceRemoteNetwork(terraformResourceName, networkName, remoteNetworkTimeouts("10m")),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckTwingateResourceExists(theResource),
+					sdk.TestCheckResourceAttr(theResource, attr.Path(attr.Timeouts, "create"), "10m"),
+				),
+			},
+		},
+	})
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/resource"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/acctests"
@@ -4644,3 +4645,144 @@ func createResourceWithAccessPolicyAndRoutingMode(groupName, remoteNetwork, reso
 	}
 	`, groupName, remoteNetwork, resource, securityPolicyID, routingMode)
 }
+
+func TestAccTwingateResourceDestroyBehaviorDeactivate(t *testing.T) {
+	t.Parallel()
+
+	resourceName := test.RandomResourceName()
+	theResource := acctests.TerraformResource(resourceName)
+	remoteNetworkName := test.RandomName()
+	resourceID := new(string)
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateResourceDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: createResourceWithDestroyBehavior(remoteNetworkName, resourceName, resource.DestroyBehaviorDeactivate),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckTwingateResourceExists(theResource),
+					sdk.TestCheckResourceAttr(theResource, attr.DestroyBehavior, resource.DestroyBehaviorDeactivate),
+					acctests.GetTwingateResourceID(theResource, &resourceID),
+				),
+			},
+			{
+				// removing the resource from config only deactivates it
+				Config: createResourceWithDestroyBehaviorRemoved(remoteNetworkName),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckTwingateResourceActiveStateByID(&resourceID, false),
+				),
+			},
+			{
+				// re-creating it adopts and reactivates the deactivated object
+				Config: createResourceWithDestroyBehavior(remoteNetworkName, resourceName, resource.DestroyBehaviorDeactivate),
+				Check: acctests.ComposeTestCheckFunc(
+					sdk.TestCheckResourceAttrWith(theResource, attr.ID, func(value string) error {
+						if value != *resourceID {
+							return fmt.Errorf("expected adopted resource ID %s, got %s", *resourceID, value) //nolint:err113
+						}
+
+						return nil
+					}),
+					acctests.CheckTwingateResourceActiveState(theResource, true),
+				),
+			},
+			{
+				Config: createResourceWithDestroyBehavior(remoteNetworkName, resourceName, resource.DestroyBehaviorDelete),
+				Check: acctests.ComposeTestCheckFunc(
+					sdk.TestCheckResourceAttr(theResource, attr.DestroyBehavior, resource.DestroyBehaviorDelete),
+				),
+			},
+		},
+	})
+}
+
+func createResourceWithDestroyBehavior(networkName, resourceName, destroyBehavior string) string {
+	return fmt.Sprintf(`
+	resource "twingate_remote_network" "%[1]s" {
+	  name = "%[1]s"
+	}
+	resource "twingate_resource" "%[2]s" {
+	  name = "%[2]s"
+	  address = "acc-test.com"
+	  remote_network_id = twingate_remote_network.%[1]s.id
+	  destroy_behavior = "%[3]s"
+	}
+	`, networkName, resourceName, destroyBehavior)
+}
+
+func createResourceWithDestroyBehaviorRemoved(networkName string) string {
+	return fmt.Sprintf(`
+	resource "twingate_remote_network" "%[1]s" {
+	  name = "%[1]s"
+	}
+	`, networkName)
+}
+
+func TestAccTwingateResourceAddressConflicts(t *testing.T) {
+	t.Parallel()
+
+	resourceName := test.RandomResourceName()
+	theResource := acctests.TerraformResource(resourceName)
+	remoteNetworkName := test.RandomName()
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateResourceDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: createResourceWithAddressConflict(remoteNetworkName, resourceName, "", false),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckTwingateResourceExists(theResource),
+				),
+			},
+			{
+				Config:      createResourceWithAddressConflict(remoteNetworkName, resourceName, providerdata.ConflictsError, true),
+				ExpectError: regexp.MustCompile("Overlapping Resource address"),
+			},
+			{
+				Config: createResourceWithAddressConflict(remoteNetworkName, resourceName, providerdata.ConflictsIgnore, true),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckTwingateResourceExists(acctests.TerraformResource(resourceName + "_subnet")),
+				),
+			},
+		},
+	})
+}
+
+func createResourceWithAddressConflict(networkName, resourceName, conflicts string, withSubnet bool) string {
+	var provider, subnet string
+
+	if conflicts != "" {
+		provider = fmt.Sprintf(`
+	provider "twingate" {
+	  resource_address_conflicts = "%s"
+	}
+	`, conflicts)
+	}
+
+	if withSubnet {
+		subnet = fmt.Sprintf(`
+	resource "twingate_resource" "%[1]s_subnet" {
+	  name = "%[1]s_subnet"
+	  address = "10.40.1.0/24"
+	  remote_network_id = twingate_remote_network.%[2]s.id
+	}
+	`, resourceName, networkName)
+	}
+
+	return fmt.Sprintf(`
+	%[3]s
+	resource "twingate_remote_network" "%[1]s" {
+	  name = "%[1]s"
+	}
+	resource "twingate_resource" "%[2]s" {
+	  name = "%[2]s"
+	  address = "10.40.0.0/16"
+	  remote_network_id = twingate_remote_network.%[1]s.id
+	}
+	%[4]s
+	`, networkName, resourceName, provider, subnet)
+}
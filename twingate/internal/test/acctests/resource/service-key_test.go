@@ -15,7 +15,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
-var ErrEmptyValue = errors.New("empty value")
+var (
+	ErrEmptyValue    = errors.New("empty value")
+	ErrKeyNotRotated = errors.New("service key was not rotated")
+)
 
 func createServiceKey(terraformResourceName, serviceAccountName string) string {
 	return fmt.Sprintf(`
@@ -417,3 +420,62 @@ func createServiceKeyV1(terraformServiceAccountName, serviceAccountName, terrafo
 	}
 	`, terraformServiceAccountName, serviceAccountName, terraformServiceAccountNameV2, serviceAccountNameV2, terraformServiceAccountKeyName, serviceAccount)
 }
+
+func createServiceKeyWithRotation(terraformResourceName, serviceAccountName string, expirationTime int, rotateBeforeExpiry string) string {
+	return fmt.Sprintf(`
+	%s
+
+	resource "twingate_service_account_key" "%s" {
+	  service_account_id = twingate_service_account.%s.id
+	  expiration_time = %v
+	  rotate_before_expiry = "%s"
+	}
+	`, createServiceAccount(terraformResourceName, serviceAccountName), terraformResourceName, terraformResourceName, expirationTime, rotateBeforeExpiry)
+}
+
+func TestAccTwingateServiceKeyRotateBeforeExpiry(t *testing.T) {
+	t.Parallel()
+
+	serviceAccountName := test.RandomName()
+	terraformResourceName := test.TerraformRandName("test_key")
+	serviceKey := acctests.TerraformServiceKey(terraformResourceName)
+
+	var firstKeyID *string
+
+	// a one day key rotated one day before expiry is due for rotation on every plan
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateServiceAccountDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: createServiceKeyWithRotation(terraformResourceName, serviceAccountName, 1, "24h"),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckTwingateResourceExists(serviceKey),
+					acctests.GetTwingateResourceID(serviceKey, &firstKeyID),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: createServiceKeyWithRotation(terraformResourceName, serviceAccountName, 1, "24h"),
+				ConfigPlanChecks: sdk.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(serviceKey, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckTwingateResourceExists(serviceKey),
+					sdk.TestCheckResourceAttr(serviceKey, attr.IsActive, "true"),
+					sdk.TestCheckResourceAttrWith(serviceKey, attr.ID, func(value string) error {
+						if value == *firstKeyID {
+							return ErrKeyNotRotated
+						}
+
+						return nil
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
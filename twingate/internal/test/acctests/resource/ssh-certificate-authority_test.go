@@ -3,7 +3,10 @@ package resource
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
@@ -12,6 +15,8 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/acctests"
 	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"golang.org/x/crypto/ssh"
 )
 
 func terraformResourceSSHCertificateAuthority(terraformResourceName, name, publicKey string) string {
@@ -44,12 +49,72 @@ func TestAccTwingateSSHCertificateAuthorityCreate(t *testing.T) {
 					sdk.TestCheckResourceAttr(theResource, attr.Name, name),
 					sdk.TestCheckResourceAttr(theResource, attr.PublicKey, publicKey),
 					sdk.TestCheckResourceAttrSet(theResource, attr.Fingerprint),
+					sdk.TestCheckResourceAttrSet(theResource, attr.OpenSSHFingerprint),
 				),
 			},
 		},
 	})
 }
 
+func TestAccTwingateSSHCertificateAuthorityGenerateKey(t *testing.T) {
+	t.Parallel()
+
+	name := test.RandomName()
+	terraformResourceName := test.TerraformRandName("test_ssh")
+	theResource := acctests.TerraformSSHCertificateAuthority(terraformResourceName)
+	privateKeyFile := filepath.Join(t.TempDir(), "ca")
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateSSHCertificateAuthorityDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "twingate_ssh_certificate_authority" "%s" {
+				  name = "%s"
+				  generate_key = {
+				    type             = "ed25519"
+				    private_key_file = "%s"
+				  }
+				}
+				`, terraformResourceName, name, privateKeyFile),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckTwingateResourceExists(theResource),
+					sdk.TestMatchResourceAttr(theResource, attr.PublicKey, regexp.MustCompile(`^ssh-ed25519 `)),
+					sdk.TestMatchResourceAttr(theResource, attr.OpenSSHFingerprint, regexp.MustCompile(`^SHA256:`)),
+					checkSSHPrivateKeyFileMatches(theResource, privateKeyFile),
+				),
+			},
+		},
+	})
+}
+
+func checkSSHPrivateKeyFileMatches(resourceName, privateKeyFile string) sdk.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		content, err := os.ReadFile(privateKeyFile)
+		if err != nil {
+			return err
+		}
+
+		signer, err := ssh.ParsePrivateKey(content)
+		if err != nil {
+			return err
+		}
+
+		if publicKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey()))); publicKey != res.Primary.Attributes[attr.PublicKey] {
+			return fmt.Errorf("private key in %s doesn't match public key %s", privateKeyFile, res.Primary.Attributes[attr.PublicKey])
+		}
+
+		return nil
+	}
+}
+
 func TestAccTwingateSSHCertificateAuthorityNameChange(t *testing.T) {
 	t.Parallel()
 
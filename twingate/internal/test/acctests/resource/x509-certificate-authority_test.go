@@ -48,6 +48,12 @@ func TestAccTwingateX509CertificateAuthorityCreate(t *testing.T) {
 					sdk.TestCheckResourceAttr(theResource, attr.Name, name),
 					sdk.TestCheckResourceAttrSet(theResource, attr.Fingerprint),
 					sdk.TestCheckNoResourceAttr(theResource, attr.Certificate),
+					sdk.TestMatchResourceAttr(theResource, attr.Subject, regexp.MustCompile(`^CN=Test CA `)),
+					sdk.TestCheckResourceAttrPair(theResource, attr.Subject, theResource, attr.Issuer),
+					sdk.TestCheckResourceAttrSet(theResource, attr.NotBefore),
+					sdk.TestCheckResourceAttrSet(theResource, attr.NotAfter),
+					sdk.TestCheckResourceAttr(theResource, attr.Serial, "01"),
+					sdk.TestCheckResourceAttr(theResource, attr.KeyAlgorithm, "RSA-2048"),
 				),
 			},
 		},
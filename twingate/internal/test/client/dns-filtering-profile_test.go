@@ -625,3 +625,142 @@ func TestClientDNSProfilesReadRequestError(t *testing.T) {
 		assert.EqualError(t, err, graphqlErr(c, "failed to read DNS filtering profile with id All", errBadRequest))
 	})
 }
+
+func TestClientDNSProfilesReadWithGroupsOk(t *testing.T) {
+	t.Run("Test Twingate Resource : Read DNS Profiles With Groups Ok", func(t *testing.T) {
+		expected := []*model.DNSFilteringProfile{
+			{
+				ID:             "id1",
+				Name:           "profile1",
+				Priority:       1,
+				FallbackMethod: model.FallbackMethodAuto,
+				Groups:         []string{"group1", "group2"},
+			},
+			{
+				ID:             "id2",
+				Name:           "profile2",
+				Priority:       2,
+				FallbackMethod: model.FallbackMethodStrict,
+				Groups:         []string{},
+			},
+		}
+
+		listResponse := `{
+		  "data": {
+		    "dnsFilteringProfiles": [
+		      {
+		        "id": "id1",
+		        "name": "profile1",
+		        "priority": 1
+		      },
+		      {
+		        "id": "id2",
+		        "name": "profile2",
+		        "priority": 2
+		      }
+		    ]
+		  }
+		}`
+
+		firstResponse := `{
+		  "data": {
+		    "dnsFilteringProfile": {
+		      "id": "id1",
+		      "name": "profile1",
+		      "priority": 1,
+		      "fallbackMethod": "AUTO",
+		      "groups": {
+		        "pageInfo": {
+		          "hasNextPage": false
+		        },
+		        "edges": [
+		          {
+		            "node": {
+		              "id": "group1"
+		            }
+		          },
+		          {
+		            "node": {
+		              "id": "group2"
+		            }
+		          }
+		        ]
+		      }
+		    }
+		  }
+		}`
+
+		secondResponse := `{
+		  "data": {
+		    "dnsFilteringProfile": {
+		      "id": "id2",
+		      "name": "profile2",
+		      "priority": 2,
+		      "fallbackMethod": "STRICT"
+		    }
+		  }
+		}`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.ResponderFromMultipleResponses([]*http.Response{
+				httpmock.NewStringResponse(200, listResponse),
+				httpmock.NewStringResponse(200, firstResponse),
+				httpmock.NewStringResponse(200, secondResponse),
+			}))
+
+		profiles, err := c.ReadDNSFilteringProfiles(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, profiles)
+	})
+}
+
+func TestClientDNSProfilesReadWithGroupsEmptyResult(t *testing.T) {
+	t.Run("Test Twingate Resource : Read DNS Profiles With Groups - Empty Result", func(t *testing.T) {
+		emptyResponse := `{
+		  "data": {
+		    "dnsFilteringProfiles": []
+		  }
+		}`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(200, emptyResponse))
+
+		profiles, err := c.ReadDNSFilteringProfiles(context.Background())
+
+		assert.NoError(t, err)
+		assert.Nil(t, profiles)
+	})
+}
+
+func TestClientDNSProfilesReadWithGroupsRequestError(t *testing.T) {
+	t.Run("Test Twingate Resource : Read DNS Profiles With Groups - Request Error", func(t *testing.T) {
+		listResponse := `{
+		  "data": {
+		    "dnsFilteringProfiles": [
+		      {
+		        "id": "id1",
+		        "name": "profile1"
+		      }
+		    ]
+		  }
+		}`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			MultipleResponders(
+				httpmock.NewStringResponder(200, listResponse),
+				httpmock.NewErrorResponder(errBadRequest),
+			))
+
+		profiles, err := c.ReadDNSFilteringProfiles(context.Background())
+
+		assert.Nil(t, profiles)
+		assert.EqualError(t, err, graphqlErr(c, "failed to read DNS filtering profile with id id1", errBadRequest))
+	})
+}
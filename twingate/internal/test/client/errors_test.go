@@ -1,6 +1,8 @@
 package client
 
 import (
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
@@ -12,3 +14,22 @@ func TestUnwrapError(t *testing.T) {
 
 	assert.Equal(t, errBadRequest, err.Unwrap())
 }
+
+func TestIsConnectorTokensInvalid(t *testing.T) {
+	cases := []struct {
+		err      error
+		expected bool
+	}{
+		{err: nil, expected: false},
+		{err: errBadRequest, expected: false},
+		{err: client.NewAPIError(client.NewHTTPError("/connector/validate_tokens", http.StatusUnauthorized, nil), "verify", "connector tokens"), expected: true},
+		{err: client.NewAPIError(client.NewHTTPError("/connector/validate_tokens", http.StatusForbidden, nil), "verify", "connector tokens"), expected: true},
+		{err: client.NewAPIError(client.NewHTTPError("/connector/validate_tokens", http.StatusBadGateway, nil), "verify", "connector tokens"), expected: false},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, client.IsConnectorTokensInvalid(c.err))
+		})
+	}
+}
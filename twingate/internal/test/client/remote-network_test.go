@@ -677,3 +677,116 @@ func TestClientReadRemoteNetworkWithNameOk(t *testing.T) {
 		assert.Equal(t, expected, network)
 	})
 }
+
+func TestClientReadRemoteNetworkConnectorsOk(t *testing.T) {
+	t.Run("Test Twingate Resource : Read Remote Network Connectors - Ok", func(t *testing.T) {
+		jsonResponse := `{
+		  "data": {
+		    "remoteNetwork": {
+		      "connectors": {
+		        "pageInfo": {
+		          "hasNextPage": false
+		        },
+		        "edges": [
+		          {
+		            "node": {
+		              "id": "connector1",
+		              "name": "tf-acc-connector1",
+		              "remoteNetwork": {
+		                "id": "network1"
+		              }
+		            }
+		          }
+		        ]
+		      }
+		    }
+		  }
+		}`
+
+		client := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", client.GraphqlServerURL,
+			httpmock.NewStringResponder(200, jsonResponse),
+		)
+
+		connectors, err := client.ReadRemoteNetworkConnectors(context.Background(), "network1")
+
+		assert.NoError(t, err)
+		assert.Len(t, connectors, 1)
+		assert.Equal(t, "connector1", connectors[0].ID)
+		assert.Equal(t, "network1", connectors[0].NetworkID)
+	})
+}
+
+func TestClientReadRemoteNetworkConnectorsEmptyNetworkID(t *testing.T) {
+	t.Run("Test Twingate Resource : Read Remote Network Connectors - Empty Network ID", func(t *testing.T) {
+		client := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+
+		connectors, err := client.ReadRemoteNetworkConnectors(context.Background(), "")
+
+		assert.Nil(t, connectors)
+		assert.EqualError(t, err, "failed to read remote network: network id is empty")
+	})
+}
+
+func TestClientReadRemoteNetworkGatewayResourcesOk(t *testing.T) {
+	t.Run("Test Twingate Resource : Read Remote Network Gateway Resources - Ok", func(t *testing.T) {
+		jsonResponse := `{
+		  "data": {
+		    "resources": {
+		      "pageInfo": {
+		        "hasNextPage": false
+		      },
+		      "edges": [
+		        {
+		          "node": {
+		            "__typename": "Resource",
+		            "id": "resource1",
+		            "name": "network resource",
+		            "address": {"value": "10.0.0.1"},
+		            "remoteNetwork": {"id": "network1"}
+		          }
+		        },
+		        {
+		          "node": {
+		            "__typename": "SSHResource",
+		            "id": "ssh1",
+		            "name": "ssh resource",
+		            "address": {"value": "10.0.0.2"},
+		            "remoteNetwork": {"id": "network1"},
+		            "gateway": {"id": "gateway1"}
+		          }
+		        },
+		        {
+		          "node": {
+		            "__typename": "KubernetesResource",
+		            "id": "k8s1",
+		            "name": "kubernetes resource",
+		            "address": {"value": "10.0.0.3"},
+		            "remoteNetwork": {"id": "network1"},
+		            "gateway": {"id": "gateway2"}
+		          }
+		        }
+		      ]
+		    }
+		  }
+		}`
+
+		client := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", client.GraphqlServerURL,
+			httpmock.NewStringResponder(200, jsonResponse),
+		)
+
+		sshResources, k8sResources, err := client.ReadRemoteNetworkGatewayResources(context.Background(), "network1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.SSHResource{
+			{ID: "ssh1", Name: "ssh resource", Address: "10.0.0.2", GatewayID: "gateway1", RemoteNetworkID: "network1"},
+		}, sshResources)
+		assert.Equal(t, []*model.KubernetesResource{
+			{ID: "k8s1", Name: "kubernetes resource", Address: "10.0.0.3", GatewayID: "gateway2", RemoteNetworkID: "network1"},
+		}, k8sResources)
+	})
+}
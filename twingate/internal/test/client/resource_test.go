@@ -1231,6 +1231,40 @@ func TestClientResourcesReadByNameRequestError(t *testing.T) {
 	})
 }
 
+func TestClientRemoteNetworkResourcesReadEmptyResult(t *testing.T) {
+	t.Run("Test Twingate Resource : Read Remote Network Resources - Empty Result", func(t *testing.T) {
+		jsonResponse := `{
+		  "data": {
+		    "resources": null
+		  }
+		}`
+
+		client := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", client.GraphqlServerURL,
+			httpmock.NewStringResponder(200, jsonResponse))
+
+		resources, err := client.ReadRemoteNetworkResources(context.Background(), "network-id")
+
+		assert.Nil(t, err)
+		assert.Empty(t, resources)
+	})
+}
+
+func TestClientRemoteNetworkResourcesReadRequestError(t *testing.T) {
+	t.Run("Test Twingate Resource : Read Remote Network Resources - Request Error", func(t *testing.T) {
+		client := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", client.GraphqlServerURL,
+			httpmock.NewErrorResponder(errBadRequest))
+
+		resources, err := client.ReadRemoteNetworkResources(context.Background(), "network-id")
+
+		assert.Nil(t, resources)
+		assert.EqualError(t, err, graphqlErr(client, "failed to read resource with id All", errBadRequest))
+	})
+}
+
 func TestClientResourcesReadByNameErrorEmptyName(t *testing.T) {
 	t.Run("Test Twingate Resource : Read Resources By Name - Error Empty Name", func(t *testing.T) {
 		jsonResponse := `{
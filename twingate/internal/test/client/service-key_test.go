@@ -497,3 +497,192 @@ func TestRevokeServiceKeyResponseError(t *testing.T) {
 		assert.EqualError(t, err, `failed to revoke service account key with id key-id: error_1`)
 	})
 }
+
+func TestReadServiceKeysOk(t *testing.T) {
+	t.Run("Test Twingate Resource: Read Service Keys - Ok", func(t *testing.T) {
+		jsonResponse := `{
+		  "data": {
+		    "serviceAccount": {
+		      "id": "account-id",
+		      "name": "account",
+		      "keys": {
+		        "pageInfo": {
+		          "hasNextPage": false
+		        },
+		        "edges": [
+		          {
+		            "node": {
+		              "id": "key-1",
+		              "name": "active key",
+		              "status": "ACTIVE",
+		              "expiresAt": ""
+		            }
+		          },
+		          {
+		            "node": {
+		              "id": "key-2",
+		              "name": "revoked key",
+		              "status": "REVOKED",
+		              "expiresAt": ""
+		            }
+		          }
+		        ]
+		      }
+		    }
+		  }
+		}`
+
+		expected := []*model.ServiceKey{
+			{ID: "key-1", Name: "active key", Status: model.StatusActive, Service: "account-id"},
+			{ID: "key-2", Name: "revoked key", Status: model.StatusRevoked, Service: "account-id"},
+		}
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(http.StatusOK, jsonResponse))
+
+		keys, err := c.ReadServiceKeys(context.Background(), "account-id")
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, keys)
+	})
+}
+
+func TestReadServiceKeysOfAllAccountsOk(t *testing.T) {
+	t.Run("Test Twingate Resource: Read Service Keys Of All Accounts - Ok", func(t *testing.T) {
+		accountsResponse := `{
+		  "data": {
+		    "serviceAccounts": {
+		      "pageInfo": {
+		        "hasNextPage": false
+		      },
+		      "edges": [
+		        {
+		          "node": {
+		            "id": "account-1",
+		            "name": "first"
+		          }
+		        },
+		        {
+		          "node": {
+		            "id": "account-2",
+		            "name": "second"
+		          }
+		        }
+		      ]
+		    }
+		  }
+		}`
+
+		firstAccountResponse := `{
+		  "data": {
+		    "serviceAccount": {
+		      "id": "account-1",
+		      "name": "first",
+		      "keys": {
+		        "pageInfo": {
+		          "hasNextPage": false
+		        },
+		        "edges": [
+		          {
+		            "node": {
+		              "id": "key-1",
+		              "name": "expiring",
+		              "status": "ACTIVE",
+		              "expiresAt": "2099-01-01T00:00:00Z"
+		            }
+		          }
+		        ]
+		      }
+		    }
+		  }
+		}`
+
+		secondAccountResponse := `{
+		  "data": {
+		    "serviceAccount": null
+		  }
+		}`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.ResponderFromMultipleResponses([]*http.Response{
+				httpmock.NewStringResponse(http.StatusOK, accountsResponse),
+				httpmock.NewStringResponse(http.StatusOK, firstAccountResponse),
+				httpmock.NewStringResponse(http.StatusOK, secondAccountResponse),
+			}))
+
+		keys, err := c.ReadServiceKeys(context.Background(), "")
+
+		assert.NoError(t, err)
+		assert.Len(t, keys, 1)
+		assert.Equal(t, "key-1", keys[0].ID)
+		assert.Equal(t, "account-1", keys[0].Service)
+		assert.Equal(t, "2099-01-01T00:00:00Z", keys[0].ExpiresAt)
+	})
+}
+
+func TestReadServiceKeysOfUnknownAccount(t *testing.T) {
+	t.Run("Test Twingate Resource: Read Service Keys Of Unknown Account", func(t *testing.T) {
+		jsonResponse := `{
+		  "data": {
+		    "serviceAccount": null
+		  }
+		}`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(http.StatusOK, jsonResponse))
+
+		keys, err := c.ReadServiceKeys(context.Background(), "account-id")
+
+		assert.Nil(t, keys)
+		assert.EqualError(t, err, `failed to read service account key with id account-id: query result is empty`)
+	})
+}
+
+func TestReadServiceKeysOfAccountWithoutKeys(t *testing.T) {
+	t.Run("Test Twingate Resource: Read Service Keys Of Account Without Keys", func(t *testing.T) {
+		jsonResponse := `{
+		  "data": {
+		    "serviceAccount": {
+		      "id": "account-id",
+		      "name": "empty",
+		      "keys": {
+		        "pageInfo": {
+		          "hasNextPage": false
+		        },
+		        "edges": []
+		      }
+		    }
+		  }
+		}`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(http.StatusOK, jsonResponse))
+
+		keys, err := c.ReadServiceKeys(context.Background(), "account-id")
+
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+}
+
+func TestReadServiceKeysRequestError(t *testing.T) {
+	t.Run("Test Twingate Resource: Read Service Keys - Request Error", func(t *testing.T) {
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewErrorResponder(errBadRequest))
+
+		keys, err := c.ReadServiceKeys(context.Background(), "account-id")
+
+		assert.Nil(t, keys)
+		assert.EqualError(t, err, graphqlErr(c, "failed to read service account key with id account-id", errBadRequest))
+	})
+}
@@ -332,10 +332,11 @@ func TestClientUserNoUpdatesOk(t *testing.T) {
 		}
 
 		expected := &model.User{
-			ID:       "user-id",
-			Email:    "some@email.com",
-			Role:     "SUPPORT",
-			IsActive: true,
+			ID:        "user-id",
+			Email:     "some@email.com",
+			Role:      "SUPPORT",
+			IsActive:  true,
+			IsPending: true,
 		}
 
 		response := `{
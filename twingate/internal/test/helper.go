@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
@@ -53,6 +54,13 @@ func RandomDomain() string {
 	return fmt.Sprintf("%s-%s.com", prefixName, acctest.RandString(domainLen))
 }
 
+// RandomPriority returns a DNS filtering profile priority that parallel tests are unlikely to share.
+func RandomPriority() string {
+	const minPriority, maxPriority = 1000, 1_000_000
+
+	return strconv.Itoa(acctest.RandIntRange(minPriority, maxPriority))
+}
+
 func RandomUserRole() string {
 	return model.UserRoles[acctest.RandIntRange(0, len(model.UserRoles)-1)]
 }
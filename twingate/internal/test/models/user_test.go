@@ -137,6 +137,13 @@ func TestUserState(t *testing.T) {
 			},
 			expected: model.UserStateActive,
 		},
+		{
+			user: model.User{
+				IsActive:  true,
+				IsPending: true,
+			},
+			expected: model.UserStatePending,
+		},
 	}
 
 	for n, c := range cases {
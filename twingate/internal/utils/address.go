@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"net/netip"
+	"strings"
+)
+
+// ParsePrefix parses an IP address or a CIDR range. A single address is returned as a
+// prefix covering just that address.
+func ParsePrefix(address string) (netip.Prefix, bool) {
+	address = strings.TrimSpace(address)
+
+	if strings.Contains(address, "/") {
+		prefix, err := netip.ParsePrefix(address)
+		if err != nil {
+			return netip.Prefix{}, false
+		}
+
+		return prefix.Masked(), true
+	}
+
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return netip.Prefix{}, false
+	}
+
+	return netip.PrefixFrom(addr, addr.BitLen()), true
+}
+
+// PrefixContains reports whether inner lies entirely within outer.
+func PrefixContains(outer, inner netip.Prefix) bool {
+	return outer.Bits() <= inner.Bits() && outer.Contains(inner.Addr())
+}
+
+// MatchWildcard reports whether the host name matches the pattern, where `*` matches any
+// sequence of characters (including dots) and `?` matches a single character. The comparison
+// ignores case and a trailing dot.
+func MatchWildcard(pattern, host string) bool {
+	pattern = strings.TrimSuffix(strings.ToLower(pattern), ".")
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+
+	return matchWildcard(pattern, host)
+}
+
+func matchWildcard(pattern, host string) bool {
+	// star and next are the positions to backtrack to after the last `*`.
+	var patternIdx, hostIdx, star, next = 0, 0, -1, 0
+
+	for hostIdx < len(host) {
+		switch {
+		case patternIdx < len(pattern) && pattern[patternIdx] == '*':
+			star = patternIdx
+			next = hostIdx
+			patternIdx++
+		case patternIdx < len(pattern) && (pattern[patternIdx] == '?' || pattern[patternIdx] == host[hostIdx]):
+			patternIdx++
+			hostIdx++
+		case star >= 0:
+			patternIdx = star + 1
+			next++
+			hostIdx = next
+		default:
+			return false
+		}
+	}
+
+	for patternIdx < len(pattern) && pattern[patternIdx] == '*' {
+		patternIdx++
+	}
+
+	return patternIdx == len(pattern)
+}
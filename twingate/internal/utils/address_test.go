@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePrefix(t *testing.T) {
+	cases := []struct {
+		address  string
+		expected string
+		ok       bool
+	}{
+		{address: "10.20.0.0/16", expected: "10.20.0.0/16", ok: true},
+		{address: "10.20.1.5/16", expected: "10.20.0.0/16", ok: true},
+		{address: "10.20.1.5", expected: "10.20.1.5/32", ok: true},
+		{address: "2001:db8::1", expected: "2001:db8::1/128", ok: true},
+		{address: "db.example.com"},
+		{address: "*.example.com"},
+		{address: "10.20.0.0/33"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.address, func(t *testing.T) {
+			prefix, ok := ParsePrefix(c.address)
+
+			assert.Equal(t, c.ok, ok)
+
+			if c.ok {
+				assert.Equal(t, c.expected, prefix.String())
+			}
+		})
+	}
+}
+
+func TestPrefixContains(t *testing.T) {
+	outer := netip.MustParsePrefix("10.20.0.0/16")
+
+	assert.True(t, PrefixContains(outer, netip.MustParsePrefix("10.20.0.0/16")))
+	assert.True(t, PrefixContains(outer, netip.MustParsePrefix("10.20.5.0/24")))
+	assert.True(t, PrefixContains(outer, netip.MustParsePrefix("10.20.5.1/32")))
+	assert.False(t, PrefixContains(outer, netip.MustParsePrefix("10.0.0.0/8")))
+	assert.False(t, PrefixContains(outer, netip.MustParsePrefix("10.21.0.0/24")))
+	assert.False(t, PrefixContains(outer, netip.MustParsePrefix("2001:db8::/64")))
+}
+
+func TestMatchWildcard(t *testing.T) {
+	cases := []struct {
+		pattern  string
+		host     string
+		expected bool
+	}{
+		{pattern: "*.example.com", host: "db.example.com", expected: true},
+		{pattern: "*.example.com", host: "db.prod.example.com", expected: true},
+		{pattern: "*.example.com", host: "example.com", expected: false},
+		{pattern: "db-?.example.com", host: "db-1.example.com", expected: true},
+		{pattern: "db-?.example.com", host: "db-10.example.com", expected: false},
+		{pattern: "*.EXAMPLE.com.", host: "Db.example.COM", expected: true},
+		{pattern: "db.example.com", host: "db.example.com", expected: true},
+		{pattern: "*db*", host: "prod-db-1.internal", expected: true},
+		{pattern: "*", host: "anything", expected: true},
+		{pattern: "a*b*c", host: "abxbyc", expected: true},
+		{pattern: "a*b*c", host: "abxbyd", expected: false},
+		{pattern: "*.example.com", host: "*.staging.example.com", expected: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.pattern+" "+c.host, func(t *testing.T) {
+			assert.Equal(t, c.expected, MatchWildcard(c.pattern, c.host))
+		})
+	}
+}
@@ -1,32 +1,195 @@
 package utils
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/sha256"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/hex"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"math/big"
+	"net"
 	"strings"
+	"time"
 )
 
-var ErrFailedDecodeCertificate = errors.New("failed to decode PEM certificate")
+const pemTypeCertificate = "CERTIFICATE"
+
+var (
+	ErrFailedDecodeCertificate = errors.New("failed to decode PEM certificate")
+	ErrUnexpectedPEMBlock      = errors.New("unexpected PEM block")
+	ErrCertificateNotCA        = errors.New("certificate is not a CA")
+	ErrCertificateKeyUsage     = errors.New("certificate key usage doesn't allow signing certificates")
+	ErrCertificateExpired      = errors.New("certificate has expired")
+	ErrCertificateNotYetValid  = errors.New("certificate is not valid yet")
+	ErrFailedDecodePrivateKey  = errors.New("failed to decode PEM private key")
+	ErrUnsupportedPrivateKey   = errors.New("unsupported private key type")
+	ErrPrivateKeyMismatch      = errors.New("private key doesn't match the certificate")
+)
+
+const (
+	serialNumberBits = 128
+	backdate         = 5 * time.Minute
+)
+
+// CertificateInfo is the metadata of a certificate exposed to Terraform.
+type CertificateInfo struct {
+	Fingerprint  string
+	Subject      string
+	Issuer       string
+	NotBefore    time.Time
+	NotAfter     time.Time
+	Serial       string
+	KeyAlgorithm string
+}
 
 // CalculateCertificateFingerprint returns the SHA-256 fingerprint of a PEM-encoded certificate
 // formatted as colon-separated uppercase hex pairs (e.g. "AB:CD:EF:...").
+// For a chain or bundle, the fingerprint is of the first (leaf) certificate.
 func CalculateCertificateFingerprint(pemCert string) (string, error) {
-	block, _ := pem.Decode([]byte(pemCert))
-	if block == nil {
-		return "", ErrFailedDecodeCertificate
+	certs, err := ParseCertificates(pemCert)
+	if err != nil {
+		return "", err
 	}
 
-	cert, err := x509.ParseCertificate(block.Bytes)
-	if err != nil {
-		return "", fmt.Errorf("failed to parse certificate: %w", err)
+	return CertificateFingerprint(certs[0]), nil
+}
+
+// ParseCertificates parses every certificate of a PEM-encoded chain or bundle, in order.
+func ParseCertificates(pemCerts string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+
+	rest := []byte(pemCerts)
+
+	for {
+		var block *pem.Block
+
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if block.Type != pemTypeCertificate {
+			return nil, fmt.Errorf("%w %q, only %q blocks are allowed", ErrUnexpectedPEMBlock, block.Type, pemTypeCertificate)
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate #%d: %w", len(certs)+1, err)
+		}
+
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 || strings.TrimSpace(string(rest)) != "" {
+		return nil, ErrFailedDecodeCertificate
+	}
+
+	return certs, nil
+}
+
+// ValidateCACertificates checks that every certificate of a chain or bundle is a CA
+// that may sign certificates and is valid at the given time.
+func ValidateCACertificates(certs []*x509.Certificate, now time.Time) error {
+	if err := ValidateCACertificateUsage(certs); err != nil {
+		return err
 	}
 
+	return ValidateCertificatesValidity(certs, now)
+}
+
+// ValidateCACertificateUsage checks that every certificate of a chain or bundle is a CA
+// that may sign certificates. It doesn't depend on the current time.
+func ValidateCACertificateUsage(certs []*x509.Certificate) error {
+	return validateEachCertificate(certs, validateCACertificateUsage)
+}
+
+// ValidateCertificatesValidity checks that every certificate of a chain or bundle is valid at the given time.
+func ValidateCertificatesValidity(certs []*x509.Certificate, now time.Time) error {
+	return validateEachCertificate(certs, func(cert *x509.Certificate) error {
+		return validateCertificateValidity(cert, now)
+	})
+}
+
+func validateEachCertificate(certs []*x509.Certificate, validate func(cert *x509.Certificate) error) error {
+	for i, cert := range certs {
+		if err := validate(cert); err != nil {
+			return fmt.Errorf("certificate #%d (%s): %w", i+1, cert.Subject, err)
+		}
+	}
+
+	return nil
+}
+
+func validateCACertificateUsage(cert *x509.Certificate) error {
+	if !cert.BasicConstraintsValid || !cert.IsCA {
+		return ErrCertificateNotCA
+	}
+
+	// An empty key usage doesn't restrict the key.
+	if cert.KeyUsage != 0 && cert.KeyUsage&x509.KeyUsageCertSign == 0 {
+		return ErrCertificateKeyUsage
+	}
+
+	return nil
+}
+
+func validateCertificateValidity(cert *x509.Certificate, now time.Time) error {
+	if now.After(cert.NotAfter) {
+		return fmt.Errorf("%w on %s", ErrCertificateExpired, cert.NotAfter.UTC().Format(time.RFC3339))
+	}
+
+	if now.Before(cert.NotBefore) {
+		return fmt.Errorf("%w until %s", ErrCertificateNotYetValid, cert.NotBefore.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// CertificateFingerprint returns the SHA-256 fingerprint of a certificate
+// formatted as colon-separated uppercase hex pairs.
+func CertificateFingerprint(cert *x509.Certificate) string {
 	hash := sha256.Sum256(cert.Raw)
-	hexStr := strings.ToUpper(hex.EncodeToString(hash[:]))
+
+	return colonHex(hash[:])
+}
+
+// NewCertificateInfo returns the metadata of a certificate.
+func NewCertificateInfo(cert *x509.Certificate) CertificateInfo {
+	return CertificateInfo{
+		Fingerprint:  CertificateFingerprint(cert),
+		Subject:      cert.Subject.String(),
+		Issuer:       cert.Issuer.String(),
+		NotBefore:    cert.NotBefore.UTC(),
+		NotAfter:     cert.NotAfter.UTC(),
+		Serial:       colonHex(cert.SerialNumber.Bytes()),
+		KeyAlgorithm: certificateKeyAlgorithm(cert),
+	}
+}
+
+// certificateKeyAlgorithm describes the public key, e.g. `RSA-2048`, `ECDSA-P-256` or `Ed25519`.
+func certificateKeyAlgorithm(cert *x509.Certificate) string {
+	switch key := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return fmt.Sprintf("RSA-%d", key.N.BitLen())
+	case *ecdsa.PublicKey:
+		return "ECDSA-" + key.Curve.Params().Name
+	case ed25519.PublicKey:
+		return "Ed25519"
+	default:
+		return cert.PublicKeyAlgorithm.String()
+	}
+}
+
+func colonHex(data []byte) string {
+	hexStr := strings.ToUpper(hex.EncodeToString(data))
 
 	var result strings.Builder
 
@@ -38,5 +201,97 @@ func CalculateCertificateFingerprint(pemCert string) (string, error) {
 		result.WriteRune(char)
 	}
 
-	return result.String(), nil
+	return result.String()
+}
+
+// ParsePrivateKey parses a PEM-encoded PKCS#8, PKCS#1 or SEC 1 private key.
+func ParsePrivateKey(pemKey string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(pemKey))
+	if block == nil {
+		return nil, ErrFailedDecodePrivateKey
+	}
+
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("%w: %T", ErrUnsupportedPrivateKey, key)
+		}
+
+		return signer, nil
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedPrivateKey, block.Type)
+	}
+
+	return key, nil
+}
+
+// IssueServerCertificate issues a TLS server certificate for the given host names and IP
+// addresses, signed by the CA. A new ECDSA P-256 key is generated for it, and the certificate
+// never outlives the CA. It returns the PEM-encoded certificate and PKCS#8 private key.
+func IssueServerCertificate(ca *x509.Certificate, caKey crypto.Signer, hosts []string, validity time.Duration) (string, string, error) {
+	if !publicKeysEqual(ca.PublicKey, caKey.Public()) {
+		return "", "", ErrPrivateKeyMismatch
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate private key: %w", err)
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), serialNumberBits))
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate serial number: %w", err)
+	}
+
+	now := time.Now()
+
+	notAfter := now.Add(validity)
+	if notAfter.After(ca.NotAfter) {
+		notAfter = ca.NotAfter
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: hosts[0]},
+		NotBefore:             now.Add(-backdate),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to issue certificate: %w", err)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal private key: %w", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemTypeCertificate, Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})),
+		nil
+}
+
+func publicKeysEqual(a, b crypto.PublicKey) bool {
+	key, ok := a.(interface{ Equal(x crypto.PublicKey) bool })
+
+	return ok && key.Equal(b)
 }
@@ -0,0 +1,205 @@
+package utils
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func generateTestCertificate(t *testing.T, template *x509.Certificate) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func testCATemplate(name string, notAfter time.Time) *x509.Certificate {
+	return &x509.Certificate{
+		SerialNumber:          big.NewInt(0x1234),
+		Subject:               pkix.Name{CommonName: name, Organization: []string{"Example"}},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+	}
+}
+
+func TestParseCertificates(t *testing.T) {
+	root := generateTestCertificate(t, testCATemplate("Root", time.Now().Add(time.Hour)))
+	intermediate := generateTestCertificate(t, testCATemplate("Intermediate", time.Now().Add(time.Hour)))
+
+	certs, err := ParseCertificates(intermediate + "\n" + root)
+	assert.NoError(t, err)
+	assert.Len(t, certs, 2)
+	assert.Equal(t, "Intermediate", certs[0].Subject.CommonName)
+	assert.Equal(t, "Root", certs[1].Subject.CommonName)
+
+	_, err = ParseCertificates("")
+	assert.ErrorIs(t, err, ErrFailedDecodeCertificate)
+
+	_, err = ParseCertificates(root + "garbage")
+	assert.ErrorIs(t, err, ErrFailedDecodeCertificate)
+
+	_, err = ParseCertificates(root + string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("key")})))
+	assert.ErrorIs(t, err, ErrUnexpectedPEMBlock)
+}
+
+func TestCalculateCertificateFingerprintOfChain(t *testing.T) {
+	leaf := generateTestCertificate(t, testCATemplate("Leaf", time.Now().Add(time.Hour)))
+	root := generateTestCertificate(t, testCATemplate("Root", time.Now().Add(time.Hour)))
+
+	leafFingerprint, err := CalculateCertificateFingerprint(leaf)
+	assert.NoError(t, err)
+
+	chainFingerprint, err := CalculateCertificateFingerprint(leaf + root)
+	assert.NoError(t, err)
+	assert.Equal(t, leafFingerprint, chainFingerprint)
+}
+
+func TestValidateCACertificates(t *testing.T) {
+	now := time.Now()
+
+	notCA := testCATemplate("Not CA", now.Add(time.Hour))
+	notCA.IsCA = false
+
+	noCertSign := testCATemplate("No cert sign", now.Add(time.Hour))
+	noCertSign.KeyUsage = x509.KeyUsageDigitalSignature
+
+	noKeyUsage := testCATemplate("No key usage", now.Add(time.Hour))
+	noKeyUsage.KeyUsage = 0
+
+	notYetValid := testCATemplate("Not yet valid", now.Add(2*time.Hour))
+	notYetValid.NotBefore = now.Add(time.Hour)
+
+	cases := []struct {
+		template    *x509.Certificate
+		expectedErr error
+	}{
+		{template: testCATemplate("Valid", now.Add(time.Hour))},
+		{template: noKeyUsage},
+		{template: notCA, expectedErr: ErrCertificateNotCA},
+		{template: noCertSign, expectedErr: ErrCertificateKeyUsage},
+		{template: testCATemplate("Expired", now.Add(-time.Minute)), expectedErr: ErrCertificateExpired},
+		{template: notYetValid, expectedErr: ErrCertificateNotYetValid},
+	}
+
+	for _, c := range cases {
+		t.Run(c.template.Subject.CommonName, func(t *testing.T) {
+			valid := generateTestCertificate(t, testCATemplate("Root", now.Add(time.Hour)))
+
+			certs, err := ParseCertificates(generateTestCertificate(t, c.template) + valid)
+			assert.NoError(t, err)
+
+			err = ValidateCACertificates(certs, now)
+			if c.expectedErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, c.expectedErr)
+				assert.True(t, strings.HasPrefix(err.Error(), "certificate #1 "), err.Error())
+			}
+		})
+	}
+}
+
+func TestNewCertificateInfo(t *testing.T) {
+	notAfter := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	certs, err := ParseCertificates(generateTestCertificate(t, testCATemplate("My CA", notAfter)))
+	assert.NoError(t, err)
+
+	info := NewCertificateInfo(certs[0])
+
+	assert.Equal(t, "CN=My CA,O=Example", info.Subject)
+	assert.Equal(t, "CN=My CA,O=Example", info.Issuer)
+	assert.Equal(t, notAfter, info.NotAfter)
+	assert.Equal(t, "12:34", info.Serial)
+	assert.Equal(t, "ECDSA-P-256", info.KeyAlgorithm)
+	assert.Equal(t, CertificateFingerprint(certs[0]), info.Fingerprint)
+	assert.Len(t, info.Fingerprint, 95)
+}
+
+func TestIssueServerCertificate(t *testing.T) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	caTemplate := testCATemplate("Gateway CA", time.Now().Add(24*time.Hour))
+
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	assert.NoError(t, err)
+
+	ca, err := x509.ParseCertificate(caDER)
+	assert.NoError(t, err)
+
+	caKeyDER, err := x509.MarshalECPrivateKey(caKey)
+	assert.NoError(t, err)
+
+	signer, err := ParsePrivateKey(string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: caKeyDER})))
+	assert.NoError(t, err)
+
+	certPEM, keyPEM, err := IssueServerCertificate(ca, signer, []string{"gateway.example.com", "10.0.0.1"}, 365*24*time.Hour)
+	assert.NoError(t, err)
+
+	certs, err := ParseCertificates(certPEM)
+	assert.NoError(t, err)
+
+	cert := certs[0]
+	assert.Equal(t, "gateway.example.com", cert.Subject.CommonName)
+	assert.Equal(t, []string{"gateway.example.com"}, cert.DNSNames)
+	assert.Equal(t, "10.0.0.1", cert.IPAddresses[0].String())
+	assert.False(t, cert.IsCA)
+	// never outlives the CA
+	assert.Equal(t, ca.NotAfter, cert.NotAfter)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+
+	_, err = cert.Verify(x509.VerifyOptions{DNSName: "gateway.example.com", Roots: roots})
+	assert.NoError(t, err)
+
+	key, err := ParsePrivateKey(keyPEM)
+	assert.NoError(t, err)
+	assert.True(t, publicKeysEqual(cert.PublicKey, key.Public()))
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	_, _, err = IssueServerCertificate(ca, otherKey, []string{"gateway.example.com"}, time.Hour)
+	assert.ErrorIs(t, err, ErrPrivateKeyMismatch)
+}
+
+func TestParsePrivateKeyInvalid(t *testing.T) {
+	_, err := ParsePrivateKey("not a key")
+	assert.ErrorIs(t, err, ErrFailedDecodePrivateKey)
+
+	_, err = ParsePrivateKey(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("garbage")})))
+	assert.ErrorIs(t, err, ErrUnsupportedPrivateKey)
+}
+
+func TestValidateCACertificateUsageIgnoresValidityPeriod(t *testing.T) {
+	now := time.Now()
+
+	certs, err := ParseCertificates(generateTestCertificate(t, testCATemplate("Expired", now.Add(-time.Minute))))
+	assert.NoError(t, err)
+
+	assert.NoError(t, ValidateCACertificateUsage(certs))
+	assert.ErrorIs(t, ValidateCertificatesValidity(certs, now), ErrCertificateExpired)
+}
@@ -0,0 +1,227 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+const (
+	DomainListFormatPlain   = "plain"
+	DomainListFormatHosts   = "hosts"
+	DomainListFormatAdblock = "adblock"
+
+	maxDomainLength      = 253
+	maxDomainLabelLength = 63
+	maxReportedErrors    = 5
+)
+
+var DomainListFormats = []string{DomainListFormatPlain, DomainListFormatHosts, DomainListFormatAdblock} //nolint:gochecknoglobals
+
+var (
+	ErrInvalidDomainList   = errors.New("invalid domain list")
+	ErrUnknownDomainFormat = errors.New("unknown domain list format")
+)
+
+// Names that hosts files map for the local machine rather than to block anything.
+var localHostNames = []string{ //nolint:gochecknoglobals
+	"localhost", "localhost.localdomain", "local", "broadcasthost", "0.0.0.0",
+	"ip6-localhost", "ip6-loopback", "ip6-localnet", "ip6-mcastprefix", "ip6-allnodes", "ip6-allrouters", "ip6-allhosts",
+}
+
+var idnaProfile = idna.New(idna.MapForLookup(), idna.Transitional(false), idna.StrictDomainName(false)) //nolint:gochecknoglobals
+
+// ParseDomainList extracts domains from list content in one of DomainListFormats:
+//   - plain: one domain per line, `#` starts a comment.
+//   - hosts: `<ip> <domain> [<domain>...]` lines, local names like `localhost` are skipped.
+//   - adblock: `||domain^` rules, comments, exceptions, cosmetic and URL rules are skipped.
+//
+// Domains are lowercased, converted to punycode and stripped of leading wildcards and trailing dots.
+// The result is sorted and deduplicated.
+func ParseDomainList(content, format string) ([]string, error) {
+	var parseLine func(line string) ([]string, error)
+
+	switch format {
+	case DomainListFormatPlain, "":
+		parseLine = parsePlainLine
+	case DomainListFormatHosts:
+		parseLine = parseHostsLine
+	case DomainListFormatAdblock:
+		parseLine = parseAdblockLine
+	default:
+		return nil, fmt.Errorf("%w %q, expected one of %s", ErrUnknownDomainFormat, format, strings.Join(DomainListFormats, ", "))
+	}
+
+	var (
+		domains []string
+		errs    []string
+	)
+
+	for number, line := range strings.Split(content, "\n") {
+		entries, err := parseLine(strings.TrimSpace(line))
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("line %d: %s", number+1, err))
+
+			continue
+		}
+
+		for _, entry := range entries {
+			domain, err := NormalizeDomain(entry)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("line %d: %s", number+1, err))
+
+				continue
+			}
+
+			domains = append(domains, domain)
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, domainListError(errs)
+	}
+
+	slices.Sort(domains)
+
+	return slices.Compact(domains), nil
+}
+
+func domainListError(errs []string) error {
+	reported := errs
+	if len(errs) > maxReportedErrors {
+		reported = errs[:maxReportedErrors]
+	}
+
+	msg := strings.Join(reported, "; ")
+	if more := len(errs) - len(reported); more > 0 {
+		msg += fmt.Sprintf(" (and %d more)", more)
+	}
+
+	return fmt.Errorf("%w: %s", ErrInvalidDomainList, msg)
+}
+
+func stripComment(line, marker string) string {
+	if idx := strings.Index(line, marker); idx >= 0 {
+		line = line[:idx]
+	}
+
+	return strings.TrimSpace(line)
+}
+
+func parsePlainLine(line string) ([]string, error) {
+	line = stripComment(line, "#")
+	if line == "" {
+		return nil, nil
+	}
+
+	if strings.ContainsAny(line, " \t") {
+		return nil, fmt.Errorf("expected a single domain, got %q", line) //nolint:err113
+	}
+
+	return []string{line}, nil
+}
+
+func parseHostsLine(line string) ([]string, error) {
+	fields := strings.Fields(stripComment(line, "#"))
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	if _, err := netip.ParseAddr(fields[0]); err != nil {
+		return nil, fmt.Errorf("expected an IP address followed by domains, got %q", line) //nolint:err113
+	}
+
+	return slices.DeleteFunc(fields[1:], func(name string) bool {
+		return slices.Contains(localHostNames, strings.ToLower(name))
+	}), nil
+}
+
+func parseAdblockLine(line string) ([]string, error) {
+	switch {
+	case line == "",
+		strings.HasPrefix(line, "!"),
+		strings.HasPrefix(line, "["),
+		strings.HasPrefix(line, "@@"),
+		strings.Contains(line, "##"), strings.Contains(line, "#@#"), strings.Contains(line, "#?#"), strings.Contains(line, "#$#"):
+		// comments, headers, exceptions and cosmetic rules don't block domains
+		return nil, nil
+	}
+
+	rule := line
+	if idx := strings.Index(rule, "$"); idx >= 0 {
+		rule = rule[:idx]
+	}
+
+	if !strings.HasPrefix(rule, "||") {
+		// hosts-compatible lists also use plain domain lines
+		return parsePlainLine(rule)
+	}
+
+	rule = strings.TrimSuffix(strings.TrimSuffix(strings.TrimPrefix(rule, "||"), "|"), "^")
+
+	if strings.ContainsAny(rule, "/^|:") {
+		// URL rules only block parts of a site
+		return nil, nil
+	}
+
+	return []string{rule}, nil
+}
+
+// NormalizeDomain lowercases the domain, converts IDNs to punycode and strips leading wildcards
+// and the trailing dot, then validates the DNS labels.
+func NormalizeDomain(domain string) (string, error) {
+	domain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+
+	for strings.HasPrefix(domain, "*.") {
+		domain = strings.TrimPrefix(domain, "*.")
+	}
+
+	domain = strings.TrimPrefix(domain, ".")
+
+	if domain == "" {
+		return "", errors.New("empty domain") //nolint:err113
+	}
+
+	if _, err := netip.ParseAddr(domain); err == nil {
+		return "", fmt.Errorf("%q is an IP address, not a domain", domain) //nolint:err113
+	}
+
+	ascii, err := idnaProfile.ToASCII(domain)
+	if err != nil {
+		return "", fmt.Errorf("%q is not a valid domain: %w", domain, err)
+	}
+
+	if len(ascii) > maxDomainLength {
+		return "", fmt.Errorf("%q is longer than %d characters", domain, maxDomainLength) //nolint:err113
+	}
+
+	for _, label := range strings.Split(ascii, ".") {
+		if err := validateDomainLabel(label); err != nil {
+			return "", fmt.Errorf("%q is not a valid domain: %w", domain, err)
+		}
+	}
+
+	return ascii, nil
+}
+
+func validateDomainLabel(label string) error {
+	if label == "" || len(label) > maxDomainLabelLength {
+		return fmt.Errorf("label %q must be between 1 and %d characters", label, maxDomainLabelLength) //nolint:err113
+	}
+
+	if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+		return fmt.Errorf("label %q can't start or end with a hyphen", label) //nolint:err113
+	}
+
+	for _, char := range label {
+		if (char < 'a' || char > 'z') && (char < '0' || char > '9') && char != '-' && char != '_' {
+			return fmt.Errorf("label %q contains invalid character %q", label, char) //nolint:err113
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDomainList(t *testing.T) {
+	cases := []struct {
+		name     string
+		format   string
+		content  string
+		expected []string
+		err      string
+	}{
+		{
+			name:   "plain",
+			format: DomainListFormatPlain,
+			content: `# blocklist
+Example.com.
+*.ads.example.com
+tracker.example.com # inline comment
+
+example.com
+bücher.de
+`,
+			expected: []string{"ads.example.com", "example.com", "tracker.example.com", "xn--bcher-kva.de"},
+		},
+		{
+			name:   "hosts",
+			format: DomainListFormatHosts,
+			content: `127.0.0.1 localhost
+::1 localhost ip6-localhost ip6-loopback
+0.0.0.0 0.0.0.0
+# ads
+0.0.0.0 ads.example.com tracker.example.com
+0.0.0.0	ads.example.com # duplicate
+`,
+			expected: []string{"ads.example.com", "tracker.example.com"},
+		},
+		{
+			name:   "adblock",
+			format: DomainListFormatAdblock,
+			content: `[Adblock Plus 2.0]
+! Title: test
+||ads.example.com^
+||tracker.example.com^$third-party
+||*.cdn.example.com^
+@@||allowed.example.com^
+example.com##.banner
+||example.com/ads/*
+plain.example.com
+`,
+			expected: []string{"ads.example.com", "cdn.example.com", "plain.example.com", "tracker.example.com"},
+		},
+		{
+			name:     "empty",
+			content:  "\n# nothing\n",
+			expected: nil,
+		},
+		{
+			name:    "invalid plain entries",
+			format:  DomainListFormatPlain,
+			content: "-bad.example.com\nexample.com\n10.0.0.1\nfoo..com\nbad_*.example.com\ntwo words",
+			err: `invalid domain list: line 1: "-bad.example.com" is not a valid domain: idna: invalid label "-bad"; ` +
+				`line 3: "10.0.0.1" is an IP address, not a domain; ` +
+				`line 4: "foo..com" is not a valid domain: label "" must be between 1 and 63 characters; ` +
+				`line 5: "bad_*.example.com" is not a valid domain: label "bad_*" contains invalid character '*'; ` +
+				`line 6: expected a single domain, got "two words"`,
+		},
+		{
+			name:    "errors are truncated",
+			content: "a b\nc d\ne f\ng h\ni j\nk l\nm n",
+			err:     `invalid domain list: line 1: expected a single domain, got "a b"; line 2: expected a single domain, got "c d"; line 3: expected a single domain, got "e f"; line 4: expected a single domain, got "g h"; line 5: expected a single domain, got "i j" (and 2 more)`,
+		},
+		{
+			name:    "hosts line without an address",
+			format:  DomainListFormatHosts,
+			content: "example.com",
+			err:     `invalid domain list: line 1: expected an IP address followed by domains, got "example.com"`,
+		},
+		{
+			name:   "unknown format",
+			format: "csv",
+			err:    `unknown domain list format "csv", expected one of plain, hosts, adblock`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			domains, err := ParseDomainList(c.content, c.format)
+
+			if c.err != "" {
+				assert.EqualError(t, err, c.err)
+				assert.Nil(t, domains)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, domains)
+		})
+	}
+}
+
+func TestNormalizeDomain(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+		err      bool
+	}{
+		{input: "EXAMPLE.com.", expected: "example.com"},
+		{input: "*.*.example.com", expected: "example.com"},
+		{input: ".example.com", expected: "example.com"},
+		{input: "мойдомен.рф", expected: "xn--d1acklchcc.xn--p1ai"},
+		{input: "_dmarc.example.com", expected: "_dmarc.example.com"},
+		{input: "", err: true},
+		{input: "*", err: true},
+		{input: "ex ample.com", err: true},
+		{input: strings.Repeat("a", 64) + ".com", err: true},
+		{input: "bad-.example.com", err: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.input, func(t *testing.T) {
+			domain, err := NormalizeDomain(c.input)
+
+			if c.err {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, domain)
+		})
+	}
+}
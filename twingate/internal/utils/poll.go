@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"context"
+	"time"
+)
+
+// Poll calls check until it reports done, returns an error or ctx is done, waiting interval between calls.
+func Poll(ctx context.Context, interval time.Duration, check func(ctx context.Context) (bool, error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		done, err := check(ctx)
+		if err != nil || done {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
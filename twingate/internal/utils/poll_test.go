@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoll(t *testing.T) {
+	t.Run("done after a few calls", func(t *testing.T) {
+		var calls int
+
+		err := Poll(context.Background(), time.Millisecond, func(_ context.Context) (bool, error) {
+			calls++
+
+			return calls == 3, nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("check error stops polling", func(t *testing.T) {
+		checkErr := errors.New("check failed")
+
+		err := Poll(context.Background(), time.Millisecond, func(_ context.Context) (bool, error) {
+			return false, checkErr
+		})
+
+		assert.ErrorIs(t, err, checkErr)
+	})
+
+	t.Run("context deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		err := Poll(ctx, time.Millisecond, func(_ context.Context) (bool, error) {
+			return false, nil
+		})
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	SSHKeyTypeEd25519 = "ed25519"
+	SSHKeyTypeECDSA   = "ecdsa"
+	SSHKeyTypeRSA     = "rsa"
+
+	sshRSAKeyBits = 4096
+)
+
+var ErrUnsupportedSSHKeyType = errors.New("unsupported SSH key type")
+
+// GenerateSSHKeyPair returns a new public key in OpenSSH authorized_keys format and its private
+// key as an OpenSSH PEM block. ECDSA keys use P-256 and RSA keys 4096 bits.
+func GenerateSSHKeyPair(keyType, comment string) (string, string, error) {
+	var (
+		privateKey crypto.Signer
+		err        error
+	)
+
+	switch keyType {
+	case SSHKeyTypeEd25519:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	case SSHKeyTypeECDSA:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case SSHKeyTypeRSA:
+		privateKey, err = rsa.GenerateKey(rand.Reader, sshRSAKeyBits)
+	default:
+		return "", "", fmt.Errorf("%w: %q", ErrUnsupportedSSHKeyType, keyType)
+	}
+
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate %s key: %w", keyType, err)
+	}
+
+	publicKey, err := ssh.NewPublicKey(privateKey.Public())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encode public key: %w", err)
+	}
+
+	block, err := ssh.MarshalPrivateKey(privateKey, comment)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encode private key: %w", err)
+	}
+
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(publicKey))), string(pem.EncodeToMemory(block)), nil
+}
+
+// CalculateSSHPublicKeyFingerprint returns the OpenSSH SHA256 fingerprint of a public key in
+// authorized_keys format, as printed by `ssh-keygen -l` (e.g. "SHA256:...").
+func CalculateSSHPublicKeyFingerprint(authorizedKey string) (string, error) {
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(authorizedKey))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse SSH public key: %w", err)
+	}
+
+	return ssh.FingerprintSHA256(publicKey), nil
+}
+
+var ErrSSHPublicKeyMismatch = errors.New("the private key doesn't match the public key")
+
+// SSHPublicKeyOfPrivateKey returns the public key of an OpenSSH or PEM-encoded private key
+// in OpenSSH authorized_keys format.
+func SSHPublicKeyOfPrivateKey(privateKey string) (string, error) {
+	signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse SSH private key: %w", err)
+	}
+
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey()))), nil
+}
+
+// MatchSSHPublicKeys checks that two public keys in authorized_keys format are the same key,
+// ignoring their comments.
+func MatchSSHPublicKeys(expected, actual string) error {
+	expectedKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(expected))
+	if err != nil {
+		return fmt.Errorf("failed to parse SSH public key: %w", err)
+	}
+
+	actualKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(actual))
+	if err != nil {
+		return fmt.Errorf("failed to parse SSH public key: %w", err)
+	}
+
+	if ssh.FingerprintSHA256(expectedKey) != ssh.FingerprintSHA256(actualKey) {
+		return fmt.Errorf("%w: %s, expected %s", ErrSSHPublicKeyMismatch, ssh.FingerprintSHA256(actualKey), ssh.FingerprintSHA256(expectedKey))
+	}
+
+	return nil
+}
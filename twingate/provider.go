@@ -59,6 +59,9 @@ type twingateProviderModel struct {
 	HTTPMaxRetry types.Int64  `tfsdk:"http_max_retry"`
 	Cache        types.Object `tfsdk:"cache"`
 	DefaultTags  types.Object `tfsdk:"default_tags"`
+
+	ResourceAddressConflicts             types.String `tfsdk:"resource_address_conflicts"`
+	DNSFilteringProfilePriorityConflicts types.String `tfsdk:"dns_filtering_profile_priority_conflicts"`
 }
 
 func New(agent, version string) func() provider.Provider {
@@ -213,6 +216,23 @@ func (t Twingate) Schema(ctx context.Context, request provider.SchemaRequest, re
 					},
 				},
 			},
+			attr.ResourceAddressConflicts: schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("How `twingate_resource` addresses duplicating, overlapping or shadowing another Resource in the same Remote Network are reported at plan time: "+
+					"`%s` (default), `%s` or `%s`.", providerdata.ConflictsWarn, providerdata.ConflictsError, providerdata.ConflictsIgnore),
+				Validators: []validator.String{
+					stringvalidator.OneOf(providerdata.ConflictsWarn, providerdata.ConflictsError, providerdata.ConflictsIgnore),
+				},
+			},
+			attr.DNSFilteringProfilePriorityConflicts: schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("How a `twingate_dns_filtering_profile` priority already used by another profile is reported at plan time: "+
+					"`%s` (default), `%s` or `%s`. A profile can't see the other changes of the same plan, so with `%s`, swapping the priorities of two profiles "+
+					"takes two applies.", providerdata.ConflictsError, providerdata.ConflictsWarn, providerdata.ConflictsIgnore, providerdata.ConflictsError),
+				Validators: []validator.String{
+					stringvalidator.OneOf(providerdata.ConflictsWarn, providerdata.ConflictsError, providerdata.ConflictsIgnore),
+				},
+			},
 			attr.DefaultTags: schema.SingleNestedAttribute{
 				Optional:    true,
 				Description: "A default set of tags applied globally to all resources created by the provider.",
@@ -293,6 +313,9 @@ func (t Twingate) Configure(ctx context.Context, request provider.ConfigureReque
 			RegionalURL: regionalURL,
 			Network:     network,
 			URL:         url,
+
+			ResourceAddressConflicts:             withDefault(config.ResourceAddressConflicts.ValueString(), providerdata.ConflictsWarn),
+			DNSFilteringProfilePriorityConflicts: withDefault(config.DNSFilteringProfilePriorityConflicts.ValueString(), providerdata.ConflictsError),
 		},
 		DefaultTags: getDefaultTags(config.DefaultTags),
 	}
@@ -526,11 +549,13 @@ func (t Twingate) DataSources(ctx context.Context) []func() datasource.DataSourc
 	return []func() datasource.DataSource{
 		twingateDatasource.NewConnectorDatasource,
 		twingateDatasource.NewConnectorsDatasource,
+		twingateDatasource.NewConnectorStatusDatasource,
 		twingateDatasource.NewGroupDatasource,
 		twingateDatasource.NewGroupsDatasource,
 		twingateDatasource.NewRemoteNetworkDatasource,
 		twingateDatasource.NewRemoteNetworksDatasource,
 		twingateDatasource.NewServiceAccountsDatasource,
+		twingateDatasource.NewServiceAccountKeysDatasource,
 		twingateDatasource.NewUserDatasource,
 		twingateDatasource.NewUsersDatasource,
 		twingateDatasource.NewSecurityPolicyDatasource,
@@ -538,10 +563,12 @@ func (t Twingate) DataSources(ctx context.Context) []func() datasource.DataSourc
 		twingateDatasource.NewResourceDatasource,
 		twingateDatasource.NewResourcesDatasource,
 		twingateDatasource.NewDNSFilteringProfileDatasource,
+		twingateDatasource.NewDNSFilteringProfilesDatasource,
 		twingateDatasource.NewX509CertificateAuthorityDatasource,
 		twingateDatasource.NewSSHCertificateAuthorityDatasource,
 		twingateDatasource.NewGatewayDatasource,
 		twingateDatasource.NewSyncToS3Datasource,
+		twingateDatasource.NewEffectiveAccessDatasource,
 	}
 }
 
@@ -570,5 +597,17 @@ func (t Twingate) EphemeralResources(ctx context.Context) []func() ephemeral.Eph
 		func() ephemeral.EphemeralResource {
 			return twingateResource.NewEphemeralConnectorTokens()
 		},
+		func() ephemeral.EphemeralResource {
+			return twingateResource.NewEphemeralConnectorDeployment()
+		},
+		func() ephemeral.EphemeralResource {
+			return twingateResource.NewEphemeralConnectorTokensVerification()
+		},
+		func() ephemeral.EphemeralResource {
+			return twingateResource.NewEphemeralGatewayTLSBundle()
+		},
+		func() ephemeral.EphemeralResource {
+			return twingateResource.NewEphemeralSSHCertificateAuthorityKey()
+		},
 	}
 }